@@ -2,11 +2,9 @@ package main
 
 import (
 	"github.com/cjp2600/protoc-gen-worm/plugin"
-	"github.com/gogo/protobuf/vanity/command"
+	"google.golang.org/protobuf/compiler/protogen"
 )
 
 func main() {
-	wg := &plugin.WormPlugin{}
-	response := command.GeneratePlugin(command.Read(), wg, ".pb.worm.go")
-	command.Write(response)
+	protogen.Options{}.Run(plugin.NewWormPlugin().Run)
 }
@@ -0,0 +1,217 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	worm "github.com/cjp2600/protoc-gen-worm/plugin/options"
+)
+
+// bytesOptions - storage options of the singular bytes field, optional and oneof bytes are stored inline
+func (w *WormPlugin) bytesOptions(field *protogen.Field) *worm.WormBytes {
+	if !isBytes(field) || isRepeated(field) || field.Oneof != nil {
+		return nil
+	}
+	opts := w.getFieldOptions(field)
+	if opts == nil {
+		return nil
+	}
+	return opts.GetBytes()
+}
+
+// isCompressedBytes - bytes are stored gzip compressed
+func (w *WormPlugin) isCompressedBytes(field *protogen.Field) bool {
+	return w.bytesOptions(field).GetCompress()
+}
+
+// isLazyBytes - bytes are stored in a separate table and loaded on demand
+func (w *WormPlugin) isLazyBytes(field *protogen.Field) bool {
+	return w.bytesOptions(field).GetLazy()
+}
+
+func (w *WormPlugin) compressedBytesName() string {
+	return w.nameWithServicePrefix("CompressedBytes")
+}
+
+// bytesGoType - model type of the compressed bytes field
+func (w *WormPlugin) bytesGoType(field *protogen.Field) (string, bool) {
+	if !w.isCompressedBytes(field) {
+		return "", false
+	}
+	return w.compressedBytesName(), true
+}
+
+// lazyBytesFields - bytes fields of the model stored in separate tables
+func (w *WormPlugin) lazyBytesFields(message *protogen.Message) []*protogen.Field {
+	var fields []*protogen.Field
+	for _, field := range message.Fields {
+		if w.isLazyBytes(field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// lazyBytesName - model of the separate bytes table, unexported (itemFileBytes)
+func (w *WormPlugin) lazyBytesName(message *protogen.Message, field *protogen.Field) string {
+	name := w.messageName(message)
+	return strings.ToLower(name[:1]) + name[1:] + field.GoName + "Bytes"
+}
+
+// lazyBytesTable - separate table of the bytes field (item_file)
+func (w *WormPlugin) lazyBytesTable(message *protogen.Message, field *protogen.Field) string {
+	return w.tableName(message) + "_" + w.columnName(field)
+}
+
+// lazyBytesSchema - table of the bytes field keyed by the owner primary key
+func (w *WormPlugin) lazyBytesSchema(message *protogen.Message, field *protogen.Field) *SchemaTable {
+	pk := w.primaryKeyField(message)
+	if pk == nil {
+		return nil
+	}
+	return &SchemaTable{
+		Name: w.lazyBytesTable(message, field),
+		Columns: []*SchemaColumn{
+			{Name: "owner_id", Type: w.columnType(pk, true), PrimaryKey: true},
+			{Name: "data", Type: w.columnType(field, false), Nullable: true},
+		},
+	}
+}
+
+// generateCompressedBytes - gzip compressed bytes type shared by the file models
+func (w *WormPlugin) generateCompressedBytes(file *protogen.File) {
+	var compressed bool
+	for _, msg := range fileMessages(file) {
+		for _, field := range msg.Fields {
+			compressed = compressed || w.isCompressedBytes(field)
+		}
+	}
+	if !compressed {
+		return
+	}
+	w.useDriver = true
+	w.useCompress = true
+
+	name := w.compressedBytesName()
+	w.P()
+	w.P(`// `, name, ` - bytes stored gzip compressed`)
+	w.P(`type `, name, ` []byte`)
+	w.P()
+	w.P(`// Value - gzip compressed bytes, empty value is stored as NULL`)
+	w.P(`func (b `, name, `) Value() (driver.Value, error) {`)
+	w.P(`if len(b) == 0 {`)
+	w.P(`return nil, nil`)
+	w.P(`}`)
+	w.P(`var buf bytes.Buffer`)
+	w.P(`zw := gzip.NewWriter(&buf)`)
+	w.P(`if _, err := zw.Write(b); err != nil {`)
+	w.P(`return nil, err`)
+	w.P(`}`)
+	w.P(`if err := zw.Close(); err != nil {`)
+	w.P(`return nil, err`)
+	w.P(`}`)
+	w.P(`return buf.Bytes(), nil`)
+	w.P(`}`)
+	w.P()
+	w.P(`// Scan - decompress the stored bytes`)
+	w.P(`func (b *`, name, `) Scan(src interface{}) error {`)
+	w.P(`var data []byte`)
+	w.P(`switch v := src.(type) {`)
+	w.P(`case nil:`)
+	w.P(`*b = nil`)
+	w.P(`return nil`)
+	w.P(`case []byte:`)
+	w.P(`data = v`)
+	w.P(`case string:`)
+	w.P(`data = []byte(v)`)
+	w.P(`default:`)
+	w.P(`return fmt.Errorf("can not scan %T into compressed bytes", src)`)
+	w.P(`}`)
+	w.P(`if len(data) == 0 {`)
+	w.P(`*b = nil`)
+	w.P(`return nil`)
+	w.P(`}`)
+	w.P(`zr, err := gzip.NewReader(bytes.NewReader(data))`)
+	w.P(`if err != nil {`)
+	w.P(`return err`)
+	w.P(`}`)
+	w.P(`defer zr.Close()`)
+	w.P(`out, err := ioutil.ReadAll(zr)`)
+	w.P(`if err != nil {`)
+	w.P(`return err`)
+	w.P(`}`)
+	w.P(`*b = out`)
+	w.P(`return nil`)
+	w.P(`}`)
+	w.P()
+}
+
+// generateLazyBytes - separate table models of the lazy bytes fields, load, save and remove methods
+func (w *WormPlugin) generateLazyBytes(message *protogen.Message) {
+	fields := w.lazyBytesFields(message)
+	if len(fields) == 0 {
+		return
+	}
+	pk := w.primaryKeyField(message)
+	if pk == nil {
+		w.Fail(fmt.Sprintf("model %s: lazy bytes fields require a primary key", message.Desc.Name()))
+		return
+	}
+	w.useCtx = true
+	mName := w.modelName(message)
+	pkName := pk.GoName
+	pkType := w.goType(pk)
+	pkType = strings.TrimPrefix(pkType, "*")
+
+	for _, field := range fields {
+		name := w.lazyBytesName(message, field)
+		table := w.lazyBytesTable(message, field)
+		fieldName := field.GoName
+		dataType := "[]byte"
+		if tp, ok := w.bytesGoType(field); ok {
+			dataType = tp
+		}
+
+		w.P(`// `, name, ` - `, fieldName, ` of `, mName, ` stored in a separate table`)
+		w.P(`type `, name, ` struct {`)
+		w.P(`OwnerID `, pkType, " `gorm:\"column:owner_id;primaryKey\"`")
+		w.P(`Data `, dataType, " `gorm:\"column:data\"`")
+		w.P(`}`)
+		w.P()
+		w.P(`func (`, name, `) TableName() string {`)
+		w.P(`return "`, table, `"`)
+		w.P(`}`)
+		w.P()
+		w.P(`// Load`, fieldName, ` - load `, fieldName, ` of the record, it is not read by the model queries`)
+		w.P(`func (e *`, mName, `) Load`, fieldName, `(ctx context.Context) ([]byte, error) {`)
+		w.P(`var rows []`, name)
+		w.P(`if err := e.dbContext(ctx).Table("`, table, `").Where("owner_id = ?", e.`, pkName, `).Limit(1).Find(&rows).Error; err != nil {`)
+		w.P(`return nil, err`)
+		w.P(`}`)
+		w.P(`e.`, fieldName, ` = nil`)
+		w.P(`if len(rows) > 0 {`)
+		w.P(`e.`, fieldName, ` = rows[0].Data`)
+		w.P(`}`)
+		w.P(`return e.`, fieldName, `, nil`)
+		w.P(`}`)
+		w.P()
+		w.P(`// save`, fieldName, ` - replace stored `, fieldName, `, empty value removes it`)
+		w.P(`func (e *`, mName, `) save`, fieldName, `(ctx context.Context) error {`)
+		w.P(`if err := e.remove`, fieldName, `(ctx); err != nil {`)
+		w.P(`return err`)
+		w.P(`}`)
+		w.P(`if len(e.`, fieldName, `) == 0 {`)
+		w.P(`return nil`)
+		w.P(`}`)
+		w.P(`return e.dbContext(ctx).Table("`, table, `").Create(&`, name, `{OwnerID: e.`, pkName, `, Data: e.`, fieldName, `}).Error`)
+		w.P(`}`)
+		w.P()
+		w.P(`// remove`, fieldName, ` - delete stored `, fieldName)
+		w.P(`func (e *`, mName, `) remove`, fieldName, `(ctx context.Context) error {`)
+		w.P(`return e.dbContext(ctx).Table("`, table, `").Where("owner_id = ?", e.`, pkName, `).Delete(&`, name, `{}).Error`)
+		w.P(`}`)
+		w.P()
+	}
+}
@@ -0,0 +1,79 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// generateCacheMethods - redis read-through methods behind the model cache key
+func (w *WormPlugin) generateCacheMethods(message *protogen.Message) {
+	mName := w.modelName(message)
+	w.useTime = true
+	w.useJson = true
+	w.useCtx = true
+
+	w.P(`// cacheKeyOf - key of the cached query, FirstCached and FindCached values do not share a key`)
+	w.P(`func (e *`, mName, `) cacheKeyOf(kind string) string {`)
+	w.P(`return e.cacheKey + ":" + kind`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// InvalidateCache - drop the values stored under the cache key`)
+	w.P(`func (e *`, mName, `) InvalidateCache() error {`)
+	w.P(`if len(e.cacheKey) == 0 {`)
+	w.P(`return nil`)
+	w.P(`}`)
+	w.P(`return `, w.connectMethodName, `().Del(e.cacheKeyOf("first"), e.cacheKeyOf("find")).Err()`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// FirstCached - first `, mName, ` record, read through the redis cache when the cache key is set,`)
+	w.P(`// the query runs in the transaction of the context, redis errors fall through to the database`)
+	w.P(`func (e *`, mName, `) FirstCached(ctx context.Context, ttl time.Duration) (*`, mName, `, error) {`)
+	w.P(`var json = jsoniter.ConfigCompatibleWithStandardLibrary`)
+	w.P(`key := e.cacheKeyOf("first")`)
+	w.P(`if len(e.cacheKey) > 0 {`)
+	w.P(`// a missing, unreachable or not readable value is replaced by the query result`)
+	w.P(`if bts, err := `, w.connectMethodName, `().Get(key).Bytes(); err == nil {`)
+	w.P(`if err := json.Unmarshal(bts, e); err == nil {`)
+	w.P(`return e, nil`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P(`if err := e.dbContext(ctx).First(e).Error; err != nil {`)
+	w.P(`return nil, err`)
+	w.P(`}`)
+	w.P(`if len(e.cacheKey) > 0 {`)
+	w.P(`// the value is cached on a best effort basis`)
+	w.P(`if bts, err := json.Marshal(e); err == nil {`)
+	w.P(w.connectMethodName, `().Set(key, bts, ttl)`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P(`return e, nil`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// FindCached - `, mName, ` records, read through the redis cache when the cache key is set,`)
+	w.P(`// the query runs in the transaction of the context, redis errors fall through to the database`)
+	w.P(`func (e *`, mName, `) FindCached(ctx context.Context, ttl time.Duration) ([]*`, mName, `, error) {`)
+	w.P(`var json = jsoniter.ConfigCompatibleWithStandardLibrary`)
+	w.P(`var items []*`, mName)
+	w.P(`key := e.cacheKeyOf("find")`)
+	w.P(`if len(e.cacheKey) > 0 {`)
+	w.P(`if bts, err := `, w.connectMethodName, `().Get(key).Bytes(); err == nil {`)
+	w.P(`if err := json.Unmarshal(bts, &items); err == nil {`)
+	w.P(`return items, nil`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P(`if err := e.dbContext(ctx).Find(&items).Error; err != nil {`)
+	w.P(`return nil, err`)
+	w.P(`}`)
+	w.P(`if len(e.cacheKey) > 0 {`)
+	w.P(`if bts, err := json.Marshal(items); err == nil {`)
+	w.P(w.connectMethodName, `().Set(key, bts, ttl)`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P(`return items, nil`)
+	w.P(`}`)
+	w.P()
+}
@@ -0,0 +1,172 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	worm "github.com/cjp2600/protoc-gen-worm/plugin/options"
+)
+
+// Config - plugin parameters, passed to the plugin as comma separated key=value pairs
+type Config struct {
+	DBDriver         string
+	SSLMode          bool
+	Suffix           string
+	MigrationsDir    string
+	MigrationVersion string
+	MigrationName    string
+	SchemaSnapshot   string
+	// SchemaDir - output directory relative to the working directory, the snapshot is read from it
+	SchemaDir        string
+	AllowDestructive bool
+	DefaultPageSize  int32
+	MaxPageSize      int32
+	// EnumStorage - storage of the enums without the field and file options
+	EnumStorage worm.EnumStorage
+	// GoModule - module= parameter, protogen strips it from the names of the generated files
+	GoModule string
+}
+
+// supportedDrivers - gorm drivers of the generated connection
+var supportedDrivers = []string{"postgres", "mysql", "mssql", "sqlserver", "sqlite"}
+
+// protogenParams - parameters handled by protogen, they are accepted without checks
+var protogenParams = map[string]bool{"paths": true, "annotate_code": true, "default_api_level": true}
+
+// NewConfig - configuration with the default values
+func NewConfig() Config {
+	return Config{
+		DBDriver:         "postgres",
+		Suffix:           ".pb.worm.go",
+		MigrationVersion: "1",
+		MigrationName:    "worm",
+		SchemaDir:        ".",
+		DefaultPageSize:  20,
+		MaxPageSize:      100,
+		EnumStorage:      worm.EnumStorage_ENUM_STORAGE_INT,
+	}
+}
+
+// ParseConfig - configuration of the plugin parameters, errors of all invalid parameters are joined
+func ParseConfig(parameter string) (Config, error) {
+	cfg := NewConfig()
+	var errs []string
+	for _, param := range strings.Split(parameter, ",") {
+		if len(param) == 0 {
+			continue
+		}
+		name, value := param, ""
+		if i := strings.Index(param, "="); i >= 0 {
+			name, value = param[:i], param[i+1:]
+		}
+		if err := cfg.set(name, value); err != nil {
+			errs = append(errs, fmt.Sprintf("parameter %s: %v", name, err))
+		}
+	}
+	if cfg.DefaultPageSize > cfg.MaxPageSize {
+		errs = append(errs, fmt.Sprintf("parameter DefaultPageSize: %d is greater than MaxPageSize %d", cfg.DefaultPageSize, cfg.MaxPageSize))
+	}
+	if len(errs) > 0 {
+		return cfg, errors.New(strings.Join(errs, "; "))
+	}
+	return cfg, nil
+}
+
+// set - store the value of the parameter
+func (c *Config) set(name, value string) error {
+	var err error
+	switch name {
+	case "DBDriver":
+		c.DBDriver, err = parseDriver(value)
+	case "SSLMode":
+		c.SSLMode, err = parseBool(value)
+	case "Suffix":
+		c.Suffix, err = parseSuffix(value)
+	case "Migrations":
+		c.MigrationsDir, err = parseString(value)
+	case "MigrationVersion":
+		c.MigrationVersion, err = parseString(value)
+	case "MigrationName":
+		c.MigrationName, err = parseString(value)
+	case "SchemaSnapshot":
+		c.SchemaSnapshot, err = parseString(value)
+	case "SchemaDir":
+		c.SchemaDir, err = parseString(value)
+	case "AllowDestructive":
+		c.AllowDestructive, err = parseBool(value)
+	case "DefaultPageSize":
+		c.DefaultPageSize, err = parsePageSize(value)
+	case "MaxPageSize":
+		c.MaxPageSize, err = parsePageSize(value)
+	case "EnumStorage":
+		c.EnumStorage, err = parseEnumStorage(value)
+	case "module":
+		c.GoModule = value
+	default:
+		if protogenParams[name] || isImportMapping(name) {
+			return nil
+		}
+		return errors.New("unknown parameter")
+	}
+	return err
+}
+
+// isImportMapping - M<file>=<import path> and apilevelM<file>=<level> parameters of protogen, the keys are proto files
+func isImportMapping(name string) bool {
+	return (strings.HasPrefix(name, "M") || strings.HasPrefix(name, "apilevelM")) && strings.HasSuffix(name, ".proto")
+}
+
+func parseDriver(value string) (string, error) {
+	driver := strings.ToLower(value)
+	for _, supported := range supportedDrivers {
+		if driver == supported {
+			return driver, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported driver %q, want one of %s", value, strings.Join(supportedDrivers, ", "))
+}
+
+// parseBool - the parameter without the value is true
+func parseBool(value string) (bool, error) {
+	if len(value) == 0 {
+		return true, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%q is not a boolean", value)
+	}
+	return b, nil
+}
+
+func parseString(value string) (string, error) {
+	if len(value) == 0 {
+		return "", errors.New("value is empty")
+	}
+	return value, nil
+}
+
+func parseSuffix(value string) (string, error) {
+	if !strings.HasSuffix(value, ".go") || value == ".pb.go" {
+		return "", fmt.Errorf("%q has to end with .go and differ from .pb.go", value)
+	}
+	return value, nil
+}
+
+func parsePageSize(value string) (int32, error) {
+	size, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || size < 1 {
+		return 0, fmt.Errorf("%q is not a positive number", value)
+	}
+	return int32(size), nil
+}
+
+// parseEnumStorage - int, string or native
+func parseEnumStorage(value string) (worm.EnumStorage, error) {
+	storage, ok := worm.EnumStorage_value["ENUM_STORAGE_"+strings.ToUpper(value)]
+	if !ok || len(value) == 0 {
+		return 0, fmt.Errorf("unsupported enum storage %q, want int, string or native", value)
+	}
+	return worm.EnumStorage(storage), nil
+}
@@ -0,0 +1,55 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+
+	worm "github.com/cjp2600/protoc-gen-worm/plugin/options"
+)
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig("paths=source_relative,module=example.com/app,Muser.proto=example.com/app/user;user," +
+		"DBDriver=MySQL,SSLMode,Migrations=migrations,MigrationVersion=2,SchemaDir=gen,DefaultPageSize=50,MaxPageSize=500,EnumStorage=string")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NewConfig()
+	want.DBDriver = "mysql"
+	want.SSLMode = true
+	want.MigrationsDir = "migrations"
+	want.MigrationVersion = "2"
+	want.SchemaDir = "gen"
+	want.DefaultPageSize = 50
+	want.MaxPageSize = 500
+	want.EnumStorage = worm.EnumStorage_ENUM_STORAGE_STRING
+	want.GoModule = "example.com/app"
+	if cfg != want {
+		t.Errorf("config %+v, want %+v", cfg, want)
+	}
+}
+
+// configErrors - invalid parameters, the error has to name the parameter
+var configErrors = []struct {
+	param string
+	err   string
+}{
+	{param: "DbDriver=postgres", err: "parameter DbDriver: unknown parameter"},
+	{param: "Migration=migrations", err: "parameter Migration: unknown parameter"},
+	{param: "DBDriver=oracle", err: `parameter DBDriver: unsupported driver "oracle"`},
+	{param: "SSLMode=yes", err: `parameter SSLMode: "yes" is not a boolean`},
+	{param: "Suffix=.pb.go", err: "parameter Suffix:"},
+	{param: "MigrationName=", err: "parameter MigrationName: value is empty"},
+	{param: "SchemaDir=", err: "parameter SchemaDir: value is empty"},
+	{param: "MaxPageSize=0", err: "parameter MaxPageSize:"},
+	{param: "DefaultPageSize=200", err: "parameter DefaultPageSize: 200 is greater than MaxPageSize 100"},
+	{param: "EnumStorage=text", err: `parameter EnumStorage: unsupported enum storage "text"`},
+}
+
+func TestParseConfigErrors(t *testing.T) {
+	for _, tc := range configErrors {
+		_, err := ParseConfig("paths=source_relative," + tc.param)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("%s: error %v, want %q", tc.param, err, tc.err)
+		}
+	}
+}
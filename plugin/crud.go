@@ -0,0 +1,237 @@
+package plugin
+
+import (
+	"strings"
+
+	"github.com/serenize/snaker"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// hasModels - check if the file declares at least one model
+func (w *WormPlugin) hasModels(file *protogen.File) bool {
+	for _, msg := range fileMessages(file) {
+		if opt, ok := w.getMessageOptions(msg); ok && opt.GetModel() {
+			return true
+		}
+	}
+	return false
+}
+
+// crudMethodName - name of the generated crud method, suffixed when the model has a field with the same name
+func (w *WormPlugin) crudMethodName(message *protogen.Message, name string) string {
+	for _, field := range message.Fields {
+		if field.GoName == name {
+			return name + "Record"
+		}
+	}
+	return name
+}
+
+// gormTagValue - value of the gorm tag setting (column:name -> name)
+func (w *WormPlugin) gormTagValue(field *protogen.Field, key string) (string, bool) {
+	opts := w.getFieldOptions(field)
+	if opts == nil || opts.Tag == nil {
+		return "", false
+	}
+	for _, setting := range strings.Split(opts.Tag.GetGorm(), ";") {
+		kv := strings.SplitN(setting, ":", 2)
+		if strings.EqualFold(strings.TrimSpace(kv[0]), key) {
+			if len(kv) == 2 {
+				return strings.TrimSpace(kv[1]), true
+			}
+			return "", true
+		}
+	}
+	return "", false
+}
+
+// impliedGormTag - gorm settings implied by the field options: native enum type,
+// lazy bytes are not model columns
+func (w *WormPlugin) impliedGormTag(field *protogen.Field) string {
+	if w.isLazyBytes(field) {
+		return "-"
+	}
+	if tp, ok := w.gormTagValue(field, "type"); ok && len(tp) > 0 {
+		return ""
+	}
+	if nativeType, ok := w.enumColumnType(field); ok {
+		return "type:" + nativeType
+	}
+	return ""
+}
+
+// columnName - db column of the field, the gorm column setting wins over the snake case name
+func (w *WormPlugin) columnName(field *protogen.Field) string {
+	if column, ok := w.gormTagValue(field, "column"); ok && len(column) > 0 {
+		return column
+	}
+	return snaker.CamelToSnake(camelCase(string(field.Desc.Name())))
+}
+
+// primaryKeyField - field declared as primary key in the gorm tag, the id field otherwise
+func (w *WormPlugin) primaryKeyField(message *protogen.Message) *protogen.Field {
+	for _, field := range message.Fields {
+		if _, ok := w.gormTagValue(field, "primary_key"); ok {
+			return field
+		}
+		if _, ok := w.gormTagValue(field, "primaryKey"); ok {
+			return field
+		}
+	}
+	for _, field := range message.Fields {
+		if strings.ToLower(string(field.Desc.Name())) == "id" {
+			return field
+		}
+	}
+	return nil
+}
+
+// primaryKeySet - condition of the model primary key which is set, non-zero numbers and non-empty strings
+func (w *WormPlugin) primaryKeySet(pk *protogen.Field) string {
+	value := `e.` + pk.GoName
+	switch {
+	case strings.HasPrefix(w.goType(pk), "*"):
+		return value + ` != nil`
+	case isNumeric(pk):
+		return value + ` != 0`
+	}
+	return `len(` + value + `) > 0`
+}
+
+func (w *WormPlugin) listOptionsName() string {
+	return w.nameWithServicePrefix("ListOptions")
+}
+
+// generateListOptions - options shared by the List methods of the file models
+func (w *WormPlugin) generateListOptions() {
+	name := w.listOptionsName()
+	w.P()
+	w.P(`// `, name, ` - filter, order and window of the generated List methods`)
+	w.P(`type `, name, ` struct {`)
+	w.P(`Where  map[string]interface{}`)
+	w.P(`Order  string`)
+	w.P(`Offset int`)
+	w.P(`Limit  int`)
+	w.P(`}`)
+	w.P()
+	w.P(`// apply - apply options to the query`)
+	w.P(`func (o *`, name, `) apply(query *gorm.DB) *gorm.DB {`)
+	w.P(`if o == nil {`)
+	w.P(`return query`)
+	w.P(`}`)
+	w.P(`if len(o.Where) > 0 {`)
+	w.P(`query = query.Where(o.Where)`)
+	w.P(`}`)
+	w.P(`if len(o.Order) > 0 {`)
+	w.P(`query = query.Order(o.Order)`)
+	w.P(`}`)
+	w.P(`if o.Offset > 0 {`)
+	w.P(`query = query.Offset(o.Offset)`)
+	w.P(`}`)
+	w.P(`if o.Limit > 0 {`)
+	w.P(`query = query.Limit(o.Limit)`)
+	w.P(`}`)
+	w.P(`return query`)
+	w.P(`}`)
+	w.P()
+}
+
+// generateCrudMethods - typed data access methods of the model
+func (w *WormPlugin) generateCrudMethods(message *protogen.Message) {
+	mName := w.modelName(message)
+	w.useCtx = true
+
+	w.P(`// dbContext - gorm object of the model bound to the context`)
+	w.P(`func (e *`, mName, `) dbContext(ctx context.Context) *gorm.DB {`)
+	if w.useTxn {
+		w.P(`if tx, ok := `, w.txnFromContext(), `(ctx); ok {`)
+		w.P(`return tx.Table(e.TableName())`)
+		w.P(`}`)
+	}
+	w.P(`return e.G().WithContext(ctx)`)
+	w.P(`}`)
+	w.P()
+
+	create := w.crudMethodName(message, "Create")
+	w.P(`// `, create, ` - insert `, mName, ` record`)
+	w.P(`func (e *`, mName, `) `, create, `(ctx context.Context) (*`, mName, `, error) {`)
+	if len(w.oneofGroups(message)) > 0 {
+		w.P(`if err := e.checkOneOfs(); err != nil {`)
+		w.P(`return nil, err`)
+		w.P(`}`)
+	}
+	w.P(`if err := e.dbContext(ctx).Create(e).Error; err != nil {`)
+	w.P(`return nil, err`)
+	w.P(`}`)
+	for _, field := range w.lazyBytesFields(message) {
+		w.P(`if err := e.save`, field.GoName, `(ctx); err != nil {`)
+		w.P(`return nil, err`)
+		w.P(`}`)
+	}
+	// cached lists do not have the new record
+	w.P(`// the record is stored even when the cache is not invalidated`)
+	w.P(`if err := e.InvalidateCache(); err != nil {`)
+	w.P(`return e, err`)
+	w.P(`}`)
+	w.P(`return e, nil`)
+	w.P(`}`)
+	w.P()
+
+	if pk := w.primaryKeyField(message); pk != nil {
+		pkName := pk.GoName
+		pkType := w.goType(pk)
+		pkType = strings.TrimPrefix(pkType, "*")
+		column := w.columnName(pk)
+
+		getByID := w.crudMethodName(message, "GetByID")
+		w.P(`// `, getByID, ` - find `, mName, ` by primary key`)
+		w.P(`func (e *`, mName, `) `, getByID, `(ctx context.Context, id `, pkType, `) (*`, mName, `, error) {`)
+		w.P(`if err := e.dbContext(ctx).Where("`, column, ` = ?", id).First(e).Error; err != nil {`)
+		w.P(`return nil, err`)
+		w.P(`}`)
+		w.P(`return e, nil`)
+		w.P(`}`)
+		w.P()
+
+		remove := w.crudMethodName(message, "Delete")
+		w.P(`// `, remove, ` - delete `, mName, ` record by primary key`)
+		w.P(`func (e *`, mName, `) `, remove, `(ctx context.Context) error {`)
+		w.P(`if err := e.dbContext(ctx).Where("`, column, ` = ?", e.`, pkName, `).Delete(e).Error; err != nil {`)
+		w.P(`return err`)
+		w.P(`}`)
+		// soft deleted records keep their bytes
+		if opt, ok := w.getMessageOptions(message); !ok || !opt.GetSoftDelete() {
+			for _, field := range w.lazyBytesFields(message) {
+				w.P(`if err := e.remove`, field.GoName, `(ctx); err != nil {`)
+				w.P(`return err`)
+				w.P(`}`)
+			}
+		}
+		w.P(`return e.InvalidateCache()`)
+		w.P(`}`)
+		w.P()
+	}
+
+	list := w.crudMethodName(message, "List")
+	w.P(`// `, list, ` - list of `, mName, ` records filtered by options`)
+	w.P(`func (e *`, mName, `) `, list, `(ctx context.Context, opts *`, w.listOptionsName(), `) ([]*`, mName, `, error) {`)
+	w.P(`var items []*`, mName)
+	w.P(`if err := opts.apply(e.dbContext(ctx)).Find(&items).Error; err != nil {`)
+	w.P(`return nil, err`)
+	w.P(`}`)
+	w.P(`return items, nil`)
+	w.P(`}`)
+	w.P()
+
+	count := w.crudMethodName(message, "Count")
+	w.P(`// `, count, ` - number of `, mName, ` records`)
+	w.P(`func (e *`, mName, `) `, count, `(ctx context.Context) (int64, error) {`)
+	w.P(`var count int64`)
+	w.P(`// the model applies the soft delete scope to the count`)
+	w.P(`if err := e.dbContext(ctx).Model(&`, mName, `{}).Count(&count).Error; err != nil {`)
+	w.P(`return 0, err`)
+	w.P(`}`)
+	w.P(`return count, nil`)
+	w.P(`}`)
+	w.P()
+}
@@ -0,0 +1,195 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/serenize/snaker"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	worm "github.com/cjp2600/protoc-gen-worm/plugin/options"
+)
+
+// SchemaEnum - native postgres enum type of the enum columns
+type SchemaEnum struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// getFileOptions - worm options of the file
+func (w *WormPlugin) getFileOptions(file *protogen.File) *worm.WormFileOptions {
+	if file == nil {
+		return nil
+	}
+	opts, _ := proto.GetExtension(file.Desc.Options(), worm.E_FileOpts).(*worm.WormFileOptions)
+	return opts
+}
+
+// enumStorage - storage of the singular enum field, the field option wins over the file option
+// and the file option wins over the EnumStorage parameter, repeated, optional and oneof enums are always stored as integers
+func (w *WormPlugin) enumStorage(field *protogen.Field) worm.EnumStorage {
+	opts := w.getFieldOptions(field)
+	if !isEnum(field) || isRepeated(field) || field.Oneof != nil || w.isOptional(field) {
+		if isEnum(field) && opts != nil && opts.EnumStorage != nil && opts.GetEnumStorage() != worm.EnumStorage_ENUM_STORAGE_INT {
+			w.Fail(fmt.Sprintf("field %s.%s: enum_storage %s is not supported for repeated, optional and oneof enums, they are stored as integers",
+				field.Parent.Desc.Name(), field.Desc.Name(), opts.GetEnumStorage()))
+		}
+		return worm.EnumStorage_ENUM_STORAGE_INT
+	}
+	if opts != nil && opts.EnumStorage != nil {
+		return opts.GetEnumStorage()
+	}
+	if opts := w.getFileOptions(w.currentFile); opts != nil && opts.EnumStorage != nil {
+		return opts.GetEnumStorage()
+	}
+	return w.EnumStorage
+}
+
+// storedEnum - enum of the field stored by the value name, the model uses the generated enum type
+func (w *WormPlugin) storedEnum(field *protogen.Field) (*protogen.Enum, bool) {
+	if w.enumStorage(field) == worm.EnumStorage_ENUM_STORAGE_INT {
+		return nil, false
+	}
+	return field.Enum, true
+}
+
+// enumModelName - model type of the stored enum
+func (w *WormPlugin) enumModelName(enum *protogen.Enum) string {
+	return w.generateModelName(enum.GoIdent.GoName)
+}
+
+// enumNativeName - name of the native postgres enum type
+func (w *WormPlugin) enumNativeName(enum *protogen.Enum) string {
+	name := string(enum.Desc.FullName())
+	if pkg := enum.Desc.ParentFile().Package(); len(pkg) > 0 {
+		name = strings.TrimPrefix(name, string(pkg)+".")
+	}
+	var parts []string
+	for _, part := range strings.Split(name, ".") {
+		parts = append(parts, snaker.CamelToSnake(part))
+	}
+	return strings.Join(parts, "_")
+}
+
+func (w *WormPlugin) enumValueNames(enum *protogen.Enum) []string {
+	values := make([]string, 0, len(enum.Values))
+	for _, value := range enum.Values {
+		values = append(values, string(value.Desc.Name()))
+	}
+	return values
+}
+
+// enumColumnType - driver specific column type of the native enum
+func (w *WormPlugin) enumColumnType(field *protogen.Field) (string, bool) {
+	if w.enumStorage(field) != worm.EnumStorage_ENUM_STORAGE_NATIVE {
+		return "", false
+	}
+	enum, ok := w.storedEnum(field)
+	if !ok {
+		return "", false
+	}
+	switch w.GetDBDriver() {
+	case "postgres":
+		return w.enumNativeName(enum), true
+	case "mysql":
+		return "enum('" + strings.Join(w.enumValueNames(enum), "','") + "')", true
+	}
+	w.Fail(fmt.Sprintf("field %s: native enum storage is supported by postgres and mysql only, driver is %s", field.Desc.Name(), w.GetDBDriver()))
+	return "", false
+}
+
+// nativeEnums - postgres enum types of the migrated models of the file
+func (w *WormPlugin) nativeEnums(file *protogen.File) []*SchemaEnum {
+	if w.GetDBDriver() != "postgres" {
+		return nil
+	}
+	var enums []*SchemaEnum
+	seen := make(map[string]bool)
+	for _, msg := range fileMessages(file) {
+		if opt, ok := w.getMessageOptions(msg); !ok || !opt.GetModel() || !opt.GetMigrate() {
+			continue
+		}
+		for _, field := range msg.Fields {
+			if w.enumStorage(field) != worm.EnumStorage_ENUM_STORAGE_NATIVE {
+				continue
+			}
+			// the gorm type setting replaces the native type
+			if tp, ok := w.gormTagValue(field, "type"); ok && len(tp) > 0 {
+				continue
+			}
+			enum, ok := w.storedEnum(field)
+			if !ok || seen[w.enumNativeName(enum)] {
+				continue
+			}
+			seen[w.enumNativeName(enum)] = true
+			enums = append(enums, &SchemaEnum{Name: w.enumNativeName(enum), Values: w.enumValueNames(enum)})
+		}
+	}
+	return enums
+}
+
+// createEnumStatement - CREATE TYPE of the native enum
+func (w *WormPlugin) createEnumStatement(enum *SchemaEnum) string {
+	return fmt.Sprintf("CREATE TYPE %s AS ENUM ('%s');\n", w.quoteIdent(enum.Name), strings.Join(enum.Values, "', '"))
+}
+
+// generateEnumTypes - model types of the enums stored by the value name, implement sql Scanner and driver Valuer,
+// the type is declared by the first file of the go package which uses the enum
+func (w *WormPlugin) generateEnumTypes(file *protogen.File) {
+	seen, ok := w.EnumTypes[file.GoImportPath]
+	if !ok {
+		seen = make(map[string]bool)
+		w.EnumTypes[file.GoImportPath] = seen
+	}
+	for _, msg := range fileMessages(file) {
+		for _, field := range msg.Fields {
+			enum, ok := w.storedEnum(field)
+			if !ok || seen[w.enumModelName(enum)] {
+				continue
+			}
+			seen[w.enumModelName(enum)] = true
+			w.useDriver = true
+
+			name := w.enumModelName(enum)
+			enumType := w.goIdent(enum.GoIdent)
+			w.P()
+			w.P(`// `, name, ` - `, enumType, ` stored by the value name`)
+			w.P(`type `, name, ` `, enumType)
+			w.P()
+			w.P(`// Value - name of the enum value`)
+			w.P(`func (x `, name, `) Value() (driver.Value, error) {`)
+			w.P(`if name, ok := `, enumType, `_name[int32(x)]; ok {`)
+			w.P(`return name, nil`)
+			w.P(`}`)
+			w.P(`return nil, fmt.Errorf("unknown `, enumType, ` value %d", x)`)
+			w.P(`}`)
+			w.P()
+			w.P(`// Scan - enum value of the stored name, integer values are accepted as well`)
+			w.P(`func (x *`, name, `) Scan(src interface{}) error {`)
+			w.P(`var name string`)
+			w.P(`switch v := src.(type) {`)
+			w.P(`case nil:`)
+			w.P(`*x = 0`)
+			w.P(`return nil`)
+			w.P(`case int64:`)
+			w.P(`*x = `, name, `(v)`)
+			w.P(`return nil`)
+			w.P(`case string:`)
+			w.P(`name = v`)
+			w.P(`case []byte:`)
+			w.P(`name = string(v)`)
+			w.P(`default:`)
+			w.P(`return fmt.Errorf("can not scan %T into `, enumType, `", src)`)
+			w.P(`}`)
+			w.P(`value, ok := `, enumType, `_value[name]`)
+			w.P(`if !ok {`)
+			w.P(`return fmt.Errorf("unknown `, enumType, ` name %q", name)`)
+			w.P(`}`)
+			w.P(`*x = `, name, `(value)`)
+			w.P(`return nil`)
+			w.P(`}`)
+		}
+	}
+	w.P()
+}
@@ -0,0 +1,59 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	worm "github.com/cjp2600/protoc-gen-worm/plugin/options"
+)
+
+// enumStorageErrors - fields of the Account message of testdata/golden/enum.proto which can not take
+// the string storage, the generation fails naming the field
+var enumStorageErrors = []struct {
+	name  string
+	field string
+	err   string
+}{
+	{name: "repeated", field: "history", err: "field Account.history: enum_storage ENUM_STORAGE_STRING is not supported"},
+	{name: "optional", field: "previous", err: "field Account.previous: enum_storage ENUM_STORAGE_STRING is not supported"},
+	{name: "oneof", field: "current", err: "field Account.current: enum_storage ENUM_STORAGE_STRING is not supported"},
+}
+
+func TestEnumStorageErrors(t *testing.T) {
+	for _, tc := range enumStorageErrors {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			set := readDescriptorSet(t, goldenDir, "enum")
+			for _, file := range set.GetFile() {
+				if file.GetName() != "enum.proto" {
+					continue
+				}
+				for _, field := range file.MessageType[0].Field {
+					if field.GetName() == tc.field {
+						setEnumStorage(field, worm.EnumStorage_ENUM_STORAGE_STRING)
+					}
+				}
+			}
+			err := NewWormPlugin().Run(generatorOf(t, set, []string{"enum"}, "DBDriver=postgres"))
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("error %v, want %q", err, tc.err)
+			}
+		})
+	}
+}
+
+// setEnumStorage - enum_storage field option, the other worm options of the field are kept
+func setEnumStorage(field *descriptorpb.FieldDescriptorProto, storage worm.EnumStorage) {
+	if field.Options == nil {
+		field.Options = &descriptorpb.FieldOptions{}
+	}
+	opts, _ := proto.GetExtension(field.Options, worm.E_Field).(*worm.WormFieldOptions)
+	if opts == nil {
+		opts = &worm.WormFieldOptions{}
+	}
+	opts.EnumStorage = storage.Enum()
+	proto.SetExtension(field.Options, worm.E_Field, opts)
+}
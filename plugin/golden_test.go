@@ -0,0 +1,340 @@
+package plugin
+
+import (
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files with the generated code")
+
+const goldenDir = "testdata/golden"
+
+// goldenImportPath - go package of the fixtures in testdata/golden, fixtures of the subdirectories are its subpackages
+const goldenImportPath = "github.com/cjp2600/protoc-gen-worm/plugin/testdata/golden"
+
+// goldenCases - fixtures of testdata/golden, the files of the case (<name>.proto by default) are compiled into the <name>.desc
+// descriptor set and the <file>.pb.go protobuf code (make golden) and generated in one request,
+// the plugin output is compared to <file>.pb.worm.go.golden
+var goldenCases = []struct {
+	name  string
+	files []string
+	param string
+}{
+	{name: "oneof", param: "DBDriver=postgres"},
+	{name: "jsonb", param: "DBDriver=postgres"},
+	{name: "timestamp", param: "DBDriver=postgres"},
+	{name: "map", param: "DBDriver=postgres"},
+	{name: "merge", param: "DBDriver=mysql"},
+	{name: "convert", param: "DBDriver=sqlite"},
+	{name: "sort", param: "DBDriver=postgres"},
+	{name: "server", param: "DBDriver=postgres"},
+	{name: "ids", param: "DBDriver=postgres"},
+	{name: "page", param: "DBDriver=postgres"},
+	{name: "enum", param: "DBDriver=postgres"},
+	{name: "txn", param: "DBDriver=postgres"},
+	{name: "mask", param: "DBDriver=postgres"},
+	{name: "wellknown", param: "DBDriver=postgres"},
+	{name: "optional", param: "DBDriver=mysql"},
+	{name: "bytes", param: "DBDriver=postgres"},
+	{name: "nested", param: "DBDriver=postgres"},
+	{name: "xref", files: []string{"xref/other/role", "xref/common", "xref/user"}, param: "DBDriver=postgres"},
+	{name: "shared", files: []string{"shared/status", "shared/ticket"}, param: "DBDriver=postgres"},
+}
+
+func TestGolden(t *testing.T) {
+	// packages imported by the generated code are type-checked from source once for all cases
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+
+	for _, tc := range goldenCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			files := tc.files
+			if len(files) == 0 {
+				files = []string{tc.name}
+			}
+			contents := generateFiles(t, goldenDir, tc.name, files, tc.param)
+			for _, file := range files {
+				golden := filepath.Join(goldenDir, file+".pb.worm.go.golden")
+				if *update {
+					if err := ioutil.WriteFile(golden, []byte(contents[file]), 0644); err != nil {
+						t.Fatal(err)
+					}
+				}
+				want, err := ioutil.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("%v, run go test ./plugin -run TestGolden -update to create it", err)
+				}
+				if line, ok := firstDiffLine(string(want), contents[file]); ok {
+					t.Errorf("generated code differs from %s at line %d:\nwant: %s\n got: %s\nrun go test ./plugin -run TestGolden -update if the change is expected",
+						golden, line+1, lineAt(string(want), line), lineAt(contents[file], line))
+				}
+			}
+			typeCheckGolden(t, fset, imp, files, contents)
+		})
+	}
+}
+
+// generateFixture - run the plugin on the <name>.desc descriptor set of the fixture, returns the .pb.worm.go content
+func generateFixture(t *testing.T, dir, name, param string) string {
+	t.Helper()
+	return generateFiles(t, dir, name, []string{name}, param)[name]
+}
+
+// generateFiles - run the plugin on the files of the <desc>.desc descriptor set in one request,
+// returns the .pb.worm.go content by the file name without the extension
+func generateFiles(t *testing.T, dir, desc string, files []string, param string) map[string]string {
+	t.Helper()
+	resp := runRequest(t, dir, desc, files, "paths=source_relative,"+param)
+	if resp.Error != nil {
+		t.Fatalf("generate %s: %s", desc, resp.GetError())
+	}
+	contents := make(map[string]string)
+	for _, file := range resp.GetFile() {
+		if strings.HasSuffix(file.GetName(), ".pb.worm.go") {
+			contents[strings.TrimSuffix(file.GetName(), ".pb.worm.go")] = file.GetContent()
+		}
+	}
+	for _, file := range files {
+		if _, ok := contents[file]; !ok {
+			t.Fatalf("generate %s: no %s.pb.worm.go in the response", desc, file)
+		}
+	}
+	return contents
+}
+
+// runPlugin - response of the plugin to the request of the <name>.proto fixture
+func runPlugin(t *testing.T, dir, name, param string) *pluginpb.CodeGeneratorResponse {
+	t.Helper()
+	return runRequest(t, dir, name, []string{name}, param)
+}
+
+// runRequest - response of the plugin to the request of the files of the <desc>.desc descriptor set
+func runRequest(t *testing.T, dir, desc string, files []string, param string) *pluginpb.CodeGeneratorResponse {
+	t.Helper()
+	gen := newGenerator(t, dir, desc, files, param)
+	if err := NewWormPlugin().Run(gen); err != nil {
+		gen.Error(err)
+	}
+	return gen.Response()
+}
+
+// newGenerator - protogen plugin of the request of the files of the <desc>.desc descriptor set
+func newGenerator(t *testing.T, dir, desc string, files []string, param string) *protogen.Plugin {
+	t.Helper()
+	return generatorOf(t, readDescriptorSet(t, dir, desc), files, param)
+}
+
+// readDescriptorSet - descriptor set of the fixture
+func readDescriptorSet(t *testing.T, dir, desc string) *descriptorpb.FileDescriptorSet {
+	t.Helper()
+	data, err := ioutil.ReadFile(filepath.Join(dir, desc+".desc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(data, &set); err != nil {
+		t.Fatalf("parse descriptor set: %v", err)
+	}
+	return &set
+}
+
+// generatorOf - protogen plugin of the request of the files of the descriptor set
+func generatorOf(t *testing.T, set *descriptorpb.FileDescriptorSet, files []string, param string) *protogen.Plugin {
+	t.Helper()
+	req := &pluginpb.CodeGeneratorRequest{
+		Parameter: proto.String(param),
+		ProtoFile: set.GetFile(),
+	}
+	for _, file := range files {
+		req.FileToGenerate = append(req.FileToGenerate, file+".proto")
+	}
+
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatalf("generate %v: %v", files, err)
+	}
+	return gen
+}
+
+// outputCases - names of the generated files for the output parameters as they are passed by protoc and buf
+var outputCases = []struct {
+	name    string
+	param   string
+	files   []string
+	pkgName string
+}{
+	{
+		name:    "import path",
+		param:   "DBDriver=sqlite",
+		files:   []string{"github.com/cjp2600/protoc-gen-worm/plugin/testdata/golden/convert.pb.worm.go"},
+		pkgName: "golden",
+	},
+	{
+		name:    "source relative",
+		param:   "paths=source_relative,DBDriver=sqlite,Suffix=.worm.go",
+		files:   []string{"convert.worm.go"},
+		pkgName: "golden",
+	},
+	{
+		name:  "module",
+		param: "module=github.com/cjp2600/protoc-gen-worm,DBDriver=sqlite,Migrations=migrations,SchemaSnapshot=testdata/missing.json",
+		files: []string{
+			"plugin/testdata/golden/convert.pb.worm.go",
+			"testdata/missing.json", "testdata/missing.diff.txt",
+			"migrations/1_worm.up.sql", "migrations/1_worm.down.sql",
+		},
+		pkgName: "golden",
+	},
+	{
+		name:    "import mapping",
+		param:   "Mconvert.proto=example.com/app/models;models,DBDriver=sqlite",
+		files:   []string{"example.com/app/models/convert.pb.worm.go"},
+		pkgName: "models",
+	},
+}
+
+func TestOutputPaths(t *testing.T) {
+	for _, tc := range outputCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			resp := runPlugin(t, goldenDir, "convert", tc.param)
+			if resp.Error != nil {
+				t.Fatalf("generate: %s", resp.GetError())
+			}
+			var names []string
+			for _, file := range resp.GetFile() {
+				names = append(names, file.GetName())
+				if strings.HasSuffix(file.GetName(), ".go") && !strings.Contains(file.GetContent(), "\npackage "+tc.pkgName+"\n") {
+					t.Errorf("%s: package %s is expected", file.GetName(), tc.pkgName)
+				}
+			}
+			if strings.Join(names, " ") != strings.Join(tc.files, " ") {
+				t.Errorf("generated files %v, want %v", names, tc.files)
+			}
+		})
+	}
+}
+
+// TestRepoProto - test.proto of the repository root (make build) is compiled into testdata/test.desc (make golden)
+// and has to generate without errors
+func TestRepoProto(t *testing.T) {
+	resp := runPlugin(t, "testdata", "test", "paths=source_relative,DBDriver=postgres")
+	if resp.Error != nil {
+		t.Fatalf("generate test.proto: %s", resp.GetError())
+	}
+	for _, file := range resp.GetFile() {
+		if !strings.HasSuffix(file.GetName(), ".go") {
+			continue
+		}
+		if _, err := parser.ParseFile(token.NewFileSet(), file.GetName(), file.GetContent(), 0); err != nil {
+			t.Errorf("parse generated code: %v", err)
+		}
+	}
+}
+
+func TestOutputSuffix(t *testing.T) {
+	resp := runPlugin(t, goldenDir, "convert", "Suffix=.pb.go")
+	if !strings.Contains(resp.GetError(), "Suffix") {
+		t.Errorf("error about the Suffix is expected, got %q", resp.GetError())
+	}
+}
+
+// typeCheckGolden - generated code has to compile together with the protobuf code of the fixtures,
+// packages of the files are checked in the order of the files, the later ones may import the earlier ones
+func typeCheckGolden(t *testing.T, fset *token.FileSet, imp types.Importer, files []string, contents map[string]string) {
+	t.Helper()
+	dir, err := filepath.Abs(goldenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pkgDirs []string
+	pkgFiles := make(map[string][]*ast.File)
+	for _, file := range files {
+		pb, err := parser.ParseFile(fset, filepath.Join(dir, file+".pb.go"), nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		worm, err := parser.ParseFile(fset, filepath.Join(dir, file+".pb.worm.go"), contents[file], 0)
+		if err != nil {
+			t.Fatalf("parse generated code of %s: %v", file, err)
+		}
+		pkgDir := filepath.ToSlash(filepath.Dir(file))
+		if _, ok := pkgFiles[pkgDir]; !ok {
+			pkgDirs = append(pkgDirs, pkgDir)
+		}
+		pkgFiles[pkgDir] = append(pkgFiles[pkgDir], pb, worm)
+
+		// grpc code of the services, the generated servers embed their unimplemented servers
+		grpcPath := filepath.Join(dir, file+"_grpc.pb.go")
+		if _, err := os.Stat(grpcPath); err == nil {
+			grpc, err := parser.ParseFile(fset, grpcPath, nil, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pkgFiles[pkgDir] = append(pkgFiles[pkgDir], grpc)
+		}
+	}
+
+	fixtures := fixtureImporter{fallback: imp, pkgs: make(map[string]*types.Package)}
+	conf := types.Config{Importer: fixtures}
+	for _, pkgDir := range pkgDirs {
+		astFiles := pkgFiles[pkgDir]
+		pkg, err := conf.Check(astFiles[0].Name.Name, fset, astFiles, nil)
+		if err != nil {
+			t.Errorf("type-check generated code of %s: %v", pkgDir, err)
+			return
+		}
+		fixtures.pkgs[path.Join(goldenImportPath, pkgDir)] = pkg
+	}
+}
+
+// fixtureImporter - packages of the fixtures checked before, other packages are imported from source
+type fixtureImporter struct {
+	fallback types.Importer
+	pkgs     map[string]*types.Package
+}
+
+func (i fixtureImporter) Import(importPath string) (*types.Package, error) {
+	if pkg, ok := i.pkgs[importPath]; ok {
+		return pkg, nil
+	}
+	return i.fallback.Import(importPath)
+}
+
+// firstDiffLine - index of the first line which differs
+func firstDiffLine(want, got string) (int, bool) {
+	if want == got {
+		return 0, false
+	}
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+	for i := range wantLines {
+		if i >= len(gotLines) || wantLines[i] != gotLines[i] {
+			return i, true
+		}
+	}
+	return len(wantLines), true
+}
+
+func lineAt(content string, i int) string {
+	lines := strings.Split(content, "\n")
+	if i < len(lines) {
+		return lines[i]
+	}
+	return "<EOF>"
+}
@@ -0,0 +1,119 @@
+package plugin
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const fieldMaskType = ".google.protobuf.FieldMask"
+
+// findFieldMaskField - update mask field of the request
+func (w *WormPlugin) findFieldMaskField(message *protogen.Message) *protogen.Field {
+	for _, field := range message.Fields {
+		if !isRepeated(field) && typeName(field) == fieldMaskType {
+			return field
+		}
+	}
+	return nil
+}
+
+// maskPaths - field mask paths of the field, proto name and json name
+func (w *WormPlugin) maskPaths(field *protogen.Field) []string {
+	paths := []string{string(field.Desc.Name())}
+	if json := field.Desc.JSONName(); len(json) > 0 && json != string(field.Desc.Name()) {
+		paths = append(paths, json)
+	}
+	return paths
+}
+
+func (w *WormPlugin) updateMaskErrorName() string {
+	return w.nameWithServicePrefix("ErrUpdateMask")
+}
+
+// generateUpdateMaskError - error of the invalid update mask shared by the file models
+func (w *WormPlugin) generateUpdateMaskError() {
+	w.P()
+	w.P(`// `, w.updateMaskErrorName(), ` - update mask is empty or has paths which can not be updated`)
+	w.P(`var `, w.updateMaskErrorName(), ` = errors.New("invalid update mask")`)
+	w.P()
+}
+
+// generateUpdateWithMaskMethod - partial update of the mask columns, zero values are written as well
+func (w *WormPlugin) generateUpdateWithMaskMethod(message *protogen.Message, privateName string) {
+	pk := w.primaryKeyField(message)
+	if pk == nil {
+		return
+	}
+	w.useCtx = true
+	w.useFieldMask = true
+	mName := w.modelName(message)
+
+	fields := message.Fields
+	if len(privateName) > 0 {
+		if val, ok := w.PrivateEntities[w.generateModelName(privateName)]; ok {
+			fields = append(fields, val.items...)
+		}
+	}
+
+	w.P(`// UpdateWithMask - update columns of the mask paths (proto or json field names), zero values included`)
+	w.P(`func (e *`, mName, `) UpdateWithMask(ctx context.Context, mask *fieldmaskpb.FieldMask) (*`, mName, `, error) {`)
+	w.P(`if len(mask.GetPaths()) == 0 {`)
+	w.P(`return nil, fmt.Errorf("%w: mask is empty", `, w.updateMaskErrorName(), `)`)
+	w.P(`}`)
+	w.P(`updateEntities := make(map[string]interface{}, len(mask.GetPaths()))`)
+	lazy := w.lazyBytesFields(message)
+	for _, field := range lazy {
+		w.P(`var save`, field.GoName, ` bool`)
+	}
+	w.P(`for _, path := range mask.GetPaths() {`)
+	w.P(`switch path {`)
+	w.P(`case "`, strings.Join(w.maskPaths(pk), `", "`), `":`)
+	w.P(`return nil, fmt.Errorf("%w: primary key %s can not be updated", `, w.updateMaskErrorName(), `, path)`)
+	for _, field := range fields {
+		if w.isLazyBytes(field) {
+			w.P(`case "`, strings.Join(w.maskPaths(field), `", "`), `":`)
+			w.P(`save`, field.GoName, ` = true`)
+			continue
+		}
+		if field == pk || !w.isColumnField(field) {
+			continue
+		}
+		w.P(`case "`, strings.Join(w.maskPaths(field), `", "`), `":`)
+		w.P(`updateEntities["`, w.columnName(field), `"] = e.`, field.GoName)
+		if siblings := w.oneofSiblings(field); len(siblings) > 0 {
+			w.P(`if `, w.oneofSet(field), ` {`)
+			for _, sibling := range siblings {
+				w.P(`updateEntities["`, sibling, `"] = nil`)
+			}
+			w.P(`}`)
+		}
+	}
+	w.P(`default:`)
+	w.P(`return nil, fmt.Errorf("%w: unknown path %s", `, w.updateMaskErrorName(), `, path)`)
+	w.P(`}`)
+	w.P(`}`)
+	if len(lazy) > 0 {
+		w.P(`if len(updateEntities) > 0 {`)
+	}
+	w.P(`if err := e.dbContext(ctx).Where("`, w.columnName(pk), ` = ?", e.`, pk.GoName, `).Updates(updateEntities).Error; err != nil {`)
+	w.P(`return nil, err`)
+	w.P(`}`)
+	if len(lazy) > 0 {
+		w.P(`}`)
+	}
+	for _, field := range lazy {
+		fieldName := field.GoName
+		w.P(`if save`, fieldName, ` {`)
+		w.P(`if err := e.save`, fieldName, `(ctx); err != nil {`)
+		w.P(`return nil, err`)
+		w.P(`}`)
+		w.P(`}`)
+	}
+	w.P(`if err := e.InvalidateCache(); err != nil {`)
+	w.P(`return e, err`)
+	w.P(`}`)
+	w.P(`return e, nil`)
+	w.P(`}`)
+	w.P()
+}
@@ -0,0 +1,367 @@
+package plugin
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	worm "github.com/cjp2600/protoc-gen-worm/plugin/options"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const timestampType = ".google.protobuf.Timestamp"
+
+// SchemaTable - table of the model as it is created by the migration
+type SchemaTable struct {
+	Name    string          `json:"name"`
+	Columns []*SchemaColumn `json:"columns"`
+	Indexes []*SchemaIndex  `json:"indexes,omitempty"`
+}
+
+// SchemaColumn - column of the model table
+type SchemaColumn struct {
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Nullable      bool   `json:"nullable,omitempty"`
+	PrimaryKey    bool   `json:"primaryKey,omitempty"`
+	AutoIncrement bool   `json:"autoIncrement,omitempty"`
+	Default       string `json:"default,omitempty"`
+}
+
+// SchemaIndex - index of the model table
+type SchemaIndex struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Unique  bool     `json:"unique,omitempty"`
+}
+
+// tableName - table of the model, the table option is used only for migrated models
+func (w *WormPlugin) tableName(message *protogen.Message) string {
+	tableName := strings.ToLower(w.messageName(message))
+	if opt, ok := w.getMessageOptions(message); ok {
+		if table := opt.GetTable(); len(table) > 0 && opt.GetMigrate() {
+			tableName = table
+		}
+	}
+	return tableName
+}
+
+// quoteIdent - driver specific quoted identifier
+func (w *WormPlugin) quoteIdent(name string) string {
+	switch w.GetDBDriver() {
+	case "mysql":
+		return "`" + name + "`"
+	case "mssql", "sqlserver":
+		return "[" + name + "]"
+	}
+	return `"` + name + `"`
+}
+
+// byDriver - sql type of the configured driver
+func (w *WormPlugin) byDriver(postgres, mysql, mssql, sqlite string) string {
+	switch w.GetDBDriver() {
+	case "mysql":
+		return mysql
+	case "mssql", "sqlserver":
+		return mssql
+	case "sqlite":
+		return sqlite
+	}
+	return postgres
+}
+
+// timestampColumnType - sql type of the timestamp columns
+func (w *WormPlugin) timestampColumnType() string {
+	return w.byDriver("timestamptz", "datetime(3)", "datetimeoffset", "datetime")
+}
+
+// columnType - driver specific sql type of the field, the gorm type setting wins
+func (w *WormPlugin) columnType(field *protogen.Field, keyed bool) string {
+	if tp, ok := w.gormTagValue(field, "type"); ok && len(tp) > 0 {
+		return tp
+	}
+	if tp, ok := w.enumColumnType(field); ok {
+		return tp
+	}
+	if opts := w.getFieldOptions(field); (opts != nil && opts.Tag != nil && opts.Tag.GetJsonb()) || w.isWellKnownJSON(field) || w.isOneOfMessage(field) {
+		return w.byDriver("jsonb", "json", "nvarchar(max)", "text")
+	}
+	if typeName(field) == timestampType {
+		return w.timestampColumnType()
+	}
+
+	kind := field.Desc.Kind()
+	if wrapper, ok := w.wrapperType(field); ok {
+		kind = wrapper.kind
+	}
+	if w.enumStorage(field) == worm.EnumStorage_ENUM_STORAGE_STRING {
+		kind = protoreflect.StringKind
+	}
+	switch typeName(field) {
+	case durationType:
+		kind = protoreflect.Int64Kind
+	case fieldMaskType:
+		kind = protoreflect.StringKind
+	}
+	switch kind {
+	case protoreflect.BoolKind:
+		return w.byDriver("boolean", "boolean", "bit", "numeric")
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind,
+		protoreflect.Sfixed32Kind, protoreflect.EnumKind:
+		return w.byDriver("integer", "int", "int", "integer")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed64Kind:
+		return w.byDriver("bigint", "bigint", "bigint", "integer")
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return w.byDriver("bigint", "bigint unsigned", "bigint", "integer")
+	case protoreflect.FloatKind:
+		return w.byDriver("real", "float", "real", "real")
+	case protoreflect.DoubleKind:
+		return w.byDriver("double precision", "double", "float", "real")
+	case protoreflect.BytesKind:
+		if size, ok := w.gormTagValue(field, "size"); ok && len(size) > 0 {
+			return w.byDriver("bytea", "varbinary("+size+")", "varbinary("+size+")", "blob")
+		}
+		if keyed {
+			return w.byDriver("bytea", "varbinary(255)", "varbinary(900)", "blob")
+		}
+		return w.byDriver("bytea", "longblob", "varbinary(max)", "blob")
+	}
+
+	// strings, mysql and mssql can not index unbounded text
+	if size, ok := w.gormTagValue(field, "size"); ok && len(size) > 0 {
+		return w.byDriver("varchar("+size+")", "varchar("+size+")", "nvarchar("+size+")", "text")
+	}
+	if keyed {
+		return w.byDriver("text", "varchar(191)", "nvarchar(191)", "text")
+	}
+	return w.byDriver("text", "longtext", "nvarchar(max)", "text")
+}
+
+// isColumnField - check if the field is stored as a column of the model table
+func (w *WormPlugin) isColumnField(field *protogen.Field) bool {
+	if opts := w.getFieldOptions(field); opts != nil && opts.Tag != nil {
+		if opts.Tag.GetJsonb() {
+			return true
+		}
+		if strings.TrimSpace(opts.Tag.GetGorm()) == "-" {
+			return false
+		}
+	}
+	if isRepeated(field) || w.isLazyBytes(field) {
+		return false
+	}
+	if isMessage(field) {
+		return w.isWellKnownColumn(field) || w.isOneOfMessage(field)
+	}
+	return true
+}
+
+// indexSettings - index name and unique flag of the field gorm tag (index, index:name,unique, uniqueIndex, unique_index)
+func (w *WormPlugin) indexSettings(field *protogen.Field) (string, bool, bool) {
+	for _, key := range []string{"index", "uniqueIndex", "unique_index"} {
+		value, ok := w.gormTagValue(field, key)
+		if !ok {
+			continue
+		}
+		unique := key != "index"
+		parts := strings.Split(value, ",")
+		for _, part := range parts[1:] {
+			if strings.EqualFold(strings.TrimSpace(part), "unique") {
+				unique = true
+			}
+		}
+		return strings.TrimSpace(parts[0]), unique, true
+	}
+	return "", false, false
+}
+
+// modelSchema - table of the model derived from the fields, merged private fields and soft delete option
+func (w *WormPlugin) modelSchema(message *protogen.Message) *SchemaTable {
+	table := &SchemaTable{Name: w.tableName(message)}
+
+	fields := message.Fields
+	if opt, ok := w.getMessageOptions(message); ok && len(opt.GetMerge()) > 0 {
+		for _, name := range strings.Split(opt.GetMerge(), ",") {
+			if val, ok := w.PrivateEntities[strings.Trim(w.generateModelName(name), " ")]; ok {
+				fields = append(fields, val.items...)
+			}
+		}
+	}
+
+	pk := w.primaryKeyField(message)
+	indexes := make(map[string]*SchemaIndex)
+	for _, field := range fields {
+		if !w.isColumnField(field) {
+			continue
+		}
+		name := w.columnName(field)
+		indexName, unique, indexed := w.indexSettings(field)
+		_, uniqueColumn := w.gormTagValue(field, "unique")
+		isPK := pk != nil && field == pk
+
+		column := &SchemaColumn{
+			Name:       name,
+			Type:       w.columnType(field, isPK || indexed || uniqueColumn),
+			Nullable:   !isPK,
+			PrimaryKey: isPK,
+		}
+		if _, ok := w.gormTagValue(field, "not null"); ok {
+			column.Nullable = false
+		}
+		if value, ok := w.gormTagValue(field, "default"); ok {
+			column.Default = value
+		}
+		if kind := field.Desc.Kind(); isPK && isScalar(field) && kind != protoreflect.BoolKind &&
+			kind != protoreflect.FloatKind && kind != protoreflect.DoubleKind {
+			if value, ok := w.gormTagValue(field, "autoIncrement"); !ok || value != "false" {
+				column.AutoIncrement = true
+			}
+		}
+		table.Columns = append(table.Columns, column)
+
+		if uniqueColumn && !indexed {
+			indexed, unique = true, true
+			indexName = "idx_" + table.Name + "_" + name
+		}
+		if !indexed {
+			continue
+		}
+		if len(indexName) == 0 {
+			indexName = "idx_" + table.Name + "_" + name
+		}
+		index, ok := indexes[indexName]
+		if !ok {
+			index = &SchemaIndex{Name: indexName}
+			indexes[indexName] = index
+			table.Indexes = append(table.Indexes, index)
+		}
+		index.Unique = index.Unique || unique
+		index.Columns = append(index.Columns, name)
+	}
+
+	if opt, ok := w.getMessageOptions(message); ok && opt.GetSoftDelete() {
+		table.Columns = append(table.Columns, &SchemaColumn{Name: "deleted_at", Type: w.timestampColumnType(), Nullable: true})
+		table.Indexes = append(table.Indexes, &SchemaIndex{Name: "idx_" + table.Name + "_deleted_at", Columns: []string{"deleted_at"}})
+	}
+	return table
+}
+
+// setSchemaTables - collect tables and native enum types of the migrated models of the file
+func (w *WormPlugin) setSchemaTables(file *protogen.File) {
+	for _, enum := range w.nativeEnums(file) {
+		if findSchemaEnum(w.Enums, enum.Name) == nil {
+			w.Enums = append(w.Enums, enum)
+		}
+	}
+	for _, msg := range fileMessages(file) {
+		if opt, ok := w.getMessageOptions(msg); ok && opt.GetModel() && opt.GetMigrate() {
+			w.Tables = append(w.Tables, w.modelSchema(msg))
+			for _, field := range w.lazyBytesFields(msg) {
+				if table := w.lazyBytesSchema(msg, field); table != nil {
+					w.Tables = append(w.Tables, table)
+				}
+			}
+		}
+	}
+}
+
+// columnDefinition - column of the CREATE TABLE statement
+func (w *WormPlugin) columnDefinition(column *SchemaColumn) string {
+	tp := column.Type
+	if column.AutoIncrement && w.isIntegerType(tp) {
+		switch w.GetDBDriver() {
+		case "postgres":
+			tp = "bigserial"
+			if column.Type == "integer" {
+				tp = "serial"
+			}
+		case "mysql":
+			tp += " AUTO_INCREMENT"
+		case "mssql", "sqlserver":
+			tp += " IDENTITY(1,1)"
+		}
+	}
+	def := w.quoteIdent(column.Name) + " " + tp
+	if !column.Nullable {
+		def += " NOT NULL"
+	}
+	if len(column.Default) > 0 {
+		def += " DEFAULT " + column.Default
+	}
+	return def
+}
+
+func (w *WormPlugin) isIntegerType(tp string) bool {
+	switch strings.ToLower(tp) {
+	case "integer", "int", "bigint", "bigint unsigned":
+		return true
+	}
+	return false
+}
+
+// createTableStatements - CREATE TABLE and CREATE INDEX statements of the table
+func (w *WormPlugin) createTableStatements(table *SchemaTable) string {
+	var lines []string
+	var pk []string
+	for _, column := range table.Columns {
+		lines = append(lines, "    "+w.columnDefinition(column))
+		if column.PrimaryKey {
+			pk = append(pk, w.quoteIdent(column.Name))
+		}
+	}
+	if len(pk) > 0 {
+		lines = append(lines, "    PRIMARY KEY ("+strings.Join(pk, ", ")+")")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE TABLE %s (\n%s\n);\n", w.quoteIdent(table.Name), strings.Join(lines, ",\n"))
+	for _, index := range table.Indexes {
+		b.WriteString(w.createIndexStatement(table, index))
+	}
+	return b.String()
+}
+
+func (w *WormPlugin) createIndexStatement(table *SchemaTable, index *SchemaIndex) string {
+	columns := make([]string, 0, len(index.Columns))
+	for _, column := range index.Columns {
+		columns = append(columns, w.quoteIdent(column))
+	}
+	unique := ""
+	if index.Unique {
+		unique = "UNIQUE "
+	}
+	return fmt.Sprintf("CREATE %sINDEX %s ON %s (%s);\n", unique, w.quoteIdent(index.Name), w.quoteIdent(table.Name), strings.Join(columns, ", "))
+}
+
+// MigrationFiles - golang-migrate up and down files of the migrated models, snapshot and diff report of the schema diff mode
+func (w *WormPlugin) MigrationFiles() {
+	schema := &Schema{Driver: w.GetDBDriver(), Enums: w.Enums, Tables: w.Tables}
+	changes := w.diffSchema(w.Snapshot, schema)
+
+	if len(w.SchemaSnapshot) > 0 {
+		w.schemaSnapshotFiles(schema, changes)
+	}
+	if len(w.MigrationsDir) == 0 || !hasStatements(changes) {
+		return
+	}
+
+	var up, down strings.Builder
+	header := "-- Code generated by protoc-gen-worm. DO NOT EDIT.\n-- driver: " + w.GetDBDriver() + "\n"
+	up.WriteString(header)
+	down.WriteString(header)
+	for _, change := range changes {
+		up.WriteString("\n")
+		up.WriteString(strings.Join(change.up, ""))
+	}
+	for i := len(changes) - 1; i >= 0; i-- {
+		down.WriteString("\n")
+		down.WriteString(strings.Join(changes[i].down, ""))
+	}
+
+	name := path.Join(w.MigrationsDir, w.MigrationVersion+"_"+w.MigrationName)
+	w.writeFile(name+".up.sql", up.String())
+	w.writeFile(name+".down.sql", down.String())
+}
@@ -0,0 +1,188 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// oneofGroups - real oneofs of the message with more than one member
+func (w *WormPlugin) oneofGroups(message *protogen.Message) []*protogen.Oneof {
+	var groups []*protogen.Oneof
+	for _, oneof := range message.Oneofs {
+		if !oneof.Desc.IsSynthetic() && len(oneof.Fields) > 1 {
+			groups = append(groups, oneof)
+		}
+	}
+	return groups
+}
+
+// oneofGoType - model type of the oneof member, nil pointer means the member is not set
+func (w *WormPlugin) oneofGoType(message *protogen.Message, field *protogen.Field) string {
+	goTyp := w.goType(field)
+	switch {
+	case typeName(field) == timestampType:
+		w.useTime = true
+		return "*time.Time"
+	case w.isWellKnownColumn(field):
+		w.Fail(fmt.Sprintf("oneof member %s.%s: well known type %s is not supported", message.Desc.Name(), field.Desc.Name(), typeName(field)))
+		return goTyp
+	case isMessage(field):
+		return "*" + w.oneofJSONName(field)
+	case isBytes(field):
+		return goTyp
+	}
+	return "*" + goTyp
+}
+
+// isOneOfMessage - message member of the oneof, it is stored as json in its own column
+func (w *WormPlugin) isOneOfMessage(field *protogen.Field) bool {
+	return w.isOneOf(field) && isMessage(field) && typeName(field) != timestampType && !w.isWellKnownColumn(field)
+}
+
+// oneofJSONName - json column type of the message member of the oneof
+func (w *WormPlugin) oneofJSONName(field *protogen.Field) string {
+	return w.nameWithServicePrefix(field.Message.GoIdent.GoName + "JSON")
+}
+
+// oneofSet - condition of the set member, the stored NULL of the message member leaves an empty json value
+func (w *WormPlugin) oneofSet(field *protogen.Field) string {
+	if w.isOneOfMessage(field) {
+		return `e.` + field.GoName + ` != nil && e.` + field.GoName + `.Message != nil`
+	}
+	return `e.` + field.GoName + ` != nil`
+}
+
+// generateOneOfJSONTypes - json column types of the message members of the oneofs of the file models
+func (w *WormPlugin) generateOneOfJSONTypes(file *protogen.File) {
+	seen := make(map[string]bool)
+	for _, msg := range fileMessages(file) {
+		for _, field := range msg.Fields {
+			if !w.isOneOfMessage(field) || seen[w.oneofJSONName(field)] {
+				continue
+			}
+			seen[w.oneofJSONName(field)] = true
+			w.generateJSONMessageType(w.oneofJSONName(field), w.goIdent(field.Message.GoIdent), false)
+		}
+	}
+}
+
+// hasOneOfGetter - scalar and timestamp members are pointers in the model, messages and bytes are nil when not set
+func (w *WormPlugin) hasOneOfGetter(field *protogen.Field) bool {
+	return w.isOneOf(field) && (!isMessage(field) || typeName(field) == timestampType) && !isBytes(field)
+}
+
+// generateOneOfGetters - value of the oneof member, zero value when the member is not set
+func (w *WormPlugin) generateOneOfGetters(message *protogen.Message) {
+	name := w.modelName(message)
+	for _, field := range message.Fields {
+		if !w.hasOneOfGetter(field) {
+			continue
+		}
+		fieldName := field.GoName
+		goTyp := strings.TrimPrefix(w.oneofGoType(message, field), "*")
+		w.P()
+		w.P(`// Get`, fieldName, ` - value of the `, fieldName, ` oneof member, zero value when it is not set`)
+		w.P(`func (e *`, name, `) Get`, fieldName, `() `, goTyp, ` {`)
+		w.P(`if e.`, fieldName, ` != nil {`)
+		w.P(`return *e.`, fieldName)
+		w.P(`}`)
+		w.P(`var zero `, goTyp)
+		w.P(`return zero`)
+		w.P(`}`)
+	}
+	w.P()
+}
+
+// generateOneOfCheck - at most one member of every oneof may be set
+func (w *WormPlugin) generateOneOfCheck(message *protogen.Message) {
+	groups := w.oneofGroups(message)
+	if len(groups) == 0 {
+		return
+	}
+	name := w.modelName(message)
+	w.P(`// checkOneOfs - oneof members are stored in separate columns, only one of them may be set`)
+	w.P(`func (e *`, name, `) checkOneOfs() error {`)
+	for _, oneof := range groups {
+		counter := oneof.GoName + "Set"
+		w.P(`var `, counter, ` int`)
+		for _, member := range oneof.Fields {
+			w.P(`if `, w.oneofSet(member), ` {`)
+			w.P(counter, `++`)
+			w.P(`}`)
+		}
+		w.P(`if `, counter, ` > 1 {`)
+		w.P(`return errors.New("oneof `, oneof.Desc.Name(), `: more than one member is set")`)
+		w.P(`}`)
+	}
+	w.P(`return nil`)
+	w.P(`}`)
+	w.P()
+}
+
+// oneofSiblings - columns of the other members of the field oneof
+func (w *WormPlugin) oneofSiblings(field *protogen.Field) []string {
+	if !w.isOneOf(field) {
+		return nil
+	}
+	var columns []string
+	for _, member := range field.Oneof.Fields {
+		if member != field && w.isColumnField(member) {
+			columns = append(columns, w.columnName(member))
+		}
+	}
+	return columns
+}
+
+// oneofToPB - set the oneof wrapper of the first set member
+func (w *WormPlugin) oneofToPB(oneof *protogen.Oneof) {
+	if len(oneof.Fields) == 0 {
+		return
+	}
+	w.P(`// oneof `, oneof.Desc.Name())
+	w.P(`switch {`)
+	for _, field := range oneof.Fields {
+		fieldName := field.GoName
+		interfaceName := w.goIdent(field.GoIdent)
+		w.P(`case `, w.oneofSet(field), `:`)
+		switch {
+		case typeName(field) == timestampType:
+			w.useTimestamp = true
+			w.P(`resp.`, oneof.GoName, ` = &`, interfaceName, `{`, fieldName, `: timestamppb.New(*e.`, fieldName, `)}`)
+		case isMessage(field):
+			w.P(`resp.`, oneof.GoName, ` = &`, interfaceName, `{`, fieldName, `: e.`, fieldName, `.Message}`)
+		case isBytes(field):
+			w.P(`resp.`, oneof.GoName, ` = &`, interfaceName, `{`, fieldName, `: e.`, fieldName, `}`)
+		default:
+			w.P(`resp.`, oneof.GoName, ` = &`, interfaceName, `{`, fieldName, `: *e.`, fieldName, `}`)
+		}
+	}
+	w.P(`}`)
+}
+
+// oneofToGorm - copy the member only when it is the set one
+func (w *WormPlugin) oneofToGorm(field *protogen.Field) {
+	fieldName := field.GoName
+	sourceName := field.Oneof.GoName
+	interfaceName := w.goIdent(field.GoIdent)
+
+	w.P(`// oneof member `, fieldName)
+	switch {
+	case typeName(field) == timestampType:
+		w.P(`if v, ok := e.Get`, sourceName, `().(*`, interfaceName, `); ok && v.`, fieldName, ` != nil {`)
+		w.P(`ut`, fieldName, ` := v.`, fieldName, `.AsTime()`)
+		w.P(`resp.`, fieldName, ` = &ut`, fieldName)
+	case isMessage(field):
+		w.P(`if v, ok := e.Get`, sourceName, `().(*`, interfaceName, `); ok && v.`, fieldName, ` != nil {`)
+		w.P(`resp.`, fieldName, ` = &`, w.oneofJSONName(field), `{Message: v.`, fieldName, `}`)
+	case isBytes(field):
+		w.P(`if v, ok := e.Get`, sourceName, `().(*`, interfaceName, `); ok {`)
+		w.P(`resp.`, fieldName, ` = append([]byte{}, v.`, fieldName, `...)`)
+	default:
+		w.P(`if v, ok := e.Get`, sourceName, `().(*`, interfaceName, `); ok {`)
+		w.P(`value := v.`, fieldName)
+		w.P(`resp.`, fieldName, ` = &value`)
+	}
+	w.P(`}`)
+}
@@ -0,0 +1,16 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// isOptional - singular scalar with explicit presence (proto3 optional, proto2 and editions fields),
+// protoc-gen-go declares it as a pointer, the model column is nullable
+func (w *WormPlugin) isOptional(field *protogen.Field) bool {
+	return field.Desc.HasPresence() && !w.isOneOf(field) && !isMessage(field) && !isRepeated(field)
+}
+
+// isOneOf - member of the real oneof, proto3 optional fields are members of the synthetic oneofs
+func (w *WormPlugin) isOneOf(field *protogen.Field) bool {
+	return field.Oneof != nil && !field.Oneof.Desc.IsSynthetic()
+}
@@ -1,477 +1,820 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
 // source: plugin/options/worm.proto
 
 package worm
 
 import (
-	fmt "fmt"
-	proto "github.com/gogo/protobuf/proto"
-	descriptor "github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
-	math "math"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
 )
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+// storage of the enum fields: integer value, value name (Scanner/Valuer) or native database enum
+type EnumStorage int32
 
-type WormFileOptions struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+const (
+	EnumStorage_ENUM_STORAGE_INT    EnumStorage = 0
+	EnumStorage_ENUM_STORAGE_STRING EnumStorage = 1
+	EnumStorage_ENUM_STORAGE_NATIVE EnumStorage = 2
+)
+
+// Enum value maps for EnumStorage.
+var (
+	EnumStorage_name = map[int32]string{
+		0: "ENUM_STORAGE_INT",
+		1: "ENUM_STORAGE_STRING",
+		2: "ENUM_STORAGE_NATIVE",
+	}
+	EnumStorage_value = map[string]int32{
+		"ENUM_STORAGE_INT":    0,
+		"ENUM_STORAGE_STRING": 1,
+		"ENUM_STORAGE_NATIVE": 2,
+	}
+)
+
+func (x EnumStorage) Enum() *EnumStorage {
+	p := new(EnumStorage)
+	*p = x
+	return p
 }
 
-func (m *WormFileOptions) Reset()         { *m = WormFileOptions{} }
-func (m *WormFileOptions) String() string { return proto.CompactTextString(m) }
-func (*WormFileOptions) ProtoMessage()    {}
-func (*WormFileOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c056d40fbc59afe5, []int{0}
+func (x EnumStorage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (m *WormFileOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WormFileOptions.Unmarshal(m, b)
+func (EnumStorage) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugin_options_worm_proto_enumTypes[0].Descriptor()
 }
-func (m *WormFileOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WormFileOptions.Marshal(b, m, deterministic)
+
+func (EnumStorage) Type() protoreflect.EnumType {
+	return &file_plugin_options_worm_proto_enumTypes[0]
 }
-func (m *WormFileOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WormFileOptions.Merge(m, src)
+
+func (x EnumStorage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
-func (m *WormFileOptions) XXX_Size() int {
-	return xxx_messageInfo_WormFileOptions.Size(m)
+
+// Deprecated: Do not use.
+func (x *EnumStorage) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = EnumStorage(num)
+	return nil
 }
-func (m *WormFileOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_WormFileOptions.DiscardUnknown(m)
+
+// Deprecated: Use EnumStorage.Descriptor instead.
+func (EnumStorage) EnumDescriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{0}
 }
 
-var xxx_messageInfo_WormFileOptions proto.InternalMessageInfo
+type WormFileOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EnumStorage   *EnumStorage           `protobuf:"varint,1,opt,name=enum_storage,json=enumStorage,enum=worm.EnumStorage" json:"enum_storage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-type WormMessageOptions struct {
-	Model                *bool    `protobuf:"varint,1,req,name=model" json:"model,omitempty"`
-	Table                *string  `protobuf:"bytes,2,opt,name=table" json:"table,omitempty"`
-	Merge                *string  `protobuf:"bytes,4,opt,name=merge" json:"merge,omitempty"`
-	Migrate              *bool    `protobuf:"varint,3,opt,name=migrate" json:"migrate,omitempty"`
-	SoftDelete           *bool    `protobuf:"varint,6,opt,name=softDelete" json:"softDelete,omitempty"`
-	ConvertTo            *string  `protobuf:"bytes,5,opt,name=convertTo" json:"convertTo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *WormMessageOptions) Reset()         { *m = WormMessageOptions{} }
-func (m *WormMessageOptions) String() string { return proto.CompactTextString(m) }
-func (*WormMessageOptions) ProtoMessage()    {}
-func (*WormMessageOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c056d40fbc59afe5, []int{1}
+func (x *WormFileOptions) Reset() {
+	*x = WormFileOptions{}
+	mi := &file_plugin_options_worm_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (m *WormMessageOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WormMessageOptions.Unmarshal(m, b)
+func (x *WormFileOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *WormMessageOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WormMessageOptions.Marshal(b, m, deterministic)
+
+func (*WormFileOptions) ProtoMessage() {}
+
+func (x *WormFileOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_options_worm_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *WormMessageOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WormMessageOptions.Merge(m, src)
+
+// Deprecated: Use WormFileOptions.ProtoReflect.Descriptor instead.
+func (*WormFileOptions) Descriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{0}
 }
-func (m *WormMessageOptions) XXX_Size() int {
-	return xxx_messageInfo_WormMessageOptions.Size(m)
+
+func (x *WormFileOptions) GetEnumStorage() EnumStorage {
+	if x != nil && x.EnumStorage != nil {
+		return *x.EnumStorage
+	}
+	return EnumStorage_ENUM_STORAGE_INT
 }
-func (m *WormMessageOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_WormMessageOptions.DiscardUnknown(m)
+
+type WormMessageOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *bool                  `protobuf:"varint,1,req,name=model" json:"model,omitempty"`
+	Table         *string                `protobuf:"bytes,2,opt,name=table" json:"table,omitempty"`
+	Merge         *string                `protobuf:"bytes,4,opt,name=merge" json:"merge,omitempty"`
+	Migrate       *bool                  `protobuf:"varint,3,opt,name=migrate" json:"migrate,omitempty"`
+	SoftDelete    *bool                  `protobuf:"varint,6,opt,name=softDelete" json:"softDelete,omitempty"`
+	ConvertTo     *string                `protobuf:"bytes,5,opt,name=convertTo" json:"convertTo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WormMessageOptions) Reset() {
+	*x = WormMessageOptions{}
+	mi := &file_plugin_options_worm_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WormMessageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WormMessageOptions) ProtoMessage() {}
+
+func (x *WormMessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_options_worm_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_WormMessageOptions proto.InternalMessageInfo
+// Deprecated: Use WormMessageOptions.ProtoReflect.Descriptor instead.
+func (*WormMessageOptions) Descriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{1}
+}
 
-func (m *WormMessageOptions) GetModel() bool {
-	if m != nil && m.Model != nil {
-		return *m.Model
+func (x *WormMessageOptions) GetModel() bool {
+	if x != nil && x.Model != nil {
+		return *x.Model
 	}
 	return false
 }
 
-func (m *WormMessageOptions) GetTable() string {
-	if m != nil && m.Table != nil {
-		return *m.Table
+func (x *WormMessageOptions) GetTable() string {
+	if x != nil && x.Table != nil {
+		return *x.Table
 	}
 	return ""
 }
 
-func (m *WormMessageOptions) GetMerge() string {
-	if m != nil && m.Merge != nil {
-		return *m.Merge
+func (x *WormMessageOptions) GetMerge() string {
+	if x != nil && x.Merge != nil {
+		return *x.Merge
 	}
 	return ""
 }
 
-func (m *WormMessageOptions) GetMigrate() bool {
-	if m != nil && m.Migrate != nil {
-		return *m.Migrate
+func (x *WormMessageOptions) GetMigrate() bool {
+	if x != nil && x.Migrate != nil {
+		return *x.Migrate
 	}
 	return false
 }
 
-func (m *WormMessageOptions) GetSoftDelete() bool {
-	if m != nil && m.SoftDelete != nil {
-		return *m.SoftDelete
+func (x *WormMessageOptions) GetSoftDelete() bool {
+	if x != nil && x.SoftDelete != nil {
+		return *x.SoftDelete
 	}
 	return false
 }
 
-func (m *WormMessageOptions) GetConvertTo() string {
-	if m != nil && m.ConvertTo != nil {
-		return *m.ConvertTo
+func (x *WormMessageOptions) GetConvertTo() string {
+	if x != nil && x.ConvertTo != nil {
+		return *x.ConvertTo
 	}
 	return ""
 }
 
 type WormFieldOptions struct {
-	Tag                  *WormTag `protobuf:"bytes,1,opt,name=tag" json:"tag,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tag           *WormTag               `protobuf:"bytes,1,opt,name=tag" json:"tag,omitempty"`
+	Sort          *WormSort              `protobuf:"bytes,2,opt,name=sort" json:"sort,omitempty"`
+	EnumStorage   *EnumStorage           `protobuf:"varint,3,opt,name=enum_storage,json=enumStorage,enum=worm.EnumStorage" json:"enum_storage,omitempty"`
+	Bytes         *WormBytes             `protobuf:"bytes,4,opt,name=bytes" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *WormFieldOptions) Reset()         { *m = WormFieldOptions{} }
-func (m *WormFieldOptions) String() string { return proto.CompactTextString(m) }
-func (*WormFieldOptions) ProtoMessage()    {}
-func (*WormFieldOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c056d40fbc59afe5, []int{2}
+func (x *WormFieldOptions) Reset() {
+	*x = WormFieldOptions{}
+	mi := &file_plugin_options_worm_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (m *WormFieldOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WormFieldOptions.Unmarshal(m, b)
+func (x *WormFieldOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *WormFieldOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WormFieldOptions.Marshal(b, m, deterministic)
+
+func (*WormFieldOptions) ProtoMessage() {}
+
+func (x *WormFieldOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_options_worm_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *WormFieldOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WormFieldOptions.Merge(m, src)
+
+// Deprecated: Use WormFieldOptions.ProtoReflect.Descriptor instead.
+func (*WormFieldOptions) Descriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{2}
 }
-func (m *WormFieldOptions) XXX_Size() int {
-	return xxx_messageInfo_WormFieldOptions.Size(m)
+
+func (x *WormFieldOptions) GetTag() *WormTag {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
 }
-func (m *WormFieldOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_WormFieldOptions.DiscardUnknown(m)
+
+func (x *WormFieldOptions) GetSort() *WormSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
 }
 
-var xxx_messageInfo_WormFieldOptions proto.InternalMessageInfo
+func (x *WormFieldOptions) GetEnumStorage() EnumStorage {
+	if x != nil && x.EnumStorage != nil {
+		return *x.EnumStorage
+	}
+	return EnumStorage_ENUM_STORAGE_INT
+}
 
-func (m *WormFieldOptions) GetTag() *WormTag {
-	if m != nil {
-		return m.Tag
+func (x *WormFieldOptions) GetBytes() *WormBytes {
+	if x != nil {
+		return x.Bytes
 	}
 	return nil
 }
 
-type WormTag struct {
-	Gorm                 *string  `protobuf:"bytes,3,opt,name=gorm" json:"gorm,omitempty"`
-	Validator            *string  `protobuf:"bytes,4,opt,name=validator" json:"validator,omitempty"`
-	Jsonb                *bool    `protobuf:"varint,5,opt,name=jsonb" json:"jsonb,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// storage of the bytes fields: gzip compressed column and/or separate table loaded on demand
+type WormBytes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Compress      *bool                  `protobuf:"varint,1,opt,name=compress" json:"compress,omitempty"`
+	Lazy          *bool                  `protobuf:"varint,2,opt,name=lazy" json:"lazy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *WormTag) Reset()         { *m = WormTag{} }
-func (m *WormTag) String() string { return proto.CompactTextString(m) }
-func (*WormTag) ProtoMessage()    {}
-func (*WormTag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c056d40fbc59afe5, []int{3}
+func (x *WormBytes) Reset() {
+	*x = WormBytes{}
+	mi := &file_plugin_options_worm_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WormBytes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WormBytes) ProtoMessage() {}
+
+func (x *WormBytes) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_options_worm_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WormBytes.ProtoReflect.Descriptor instead.
+func (*WormBytes) Descriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WormBytes) GetCompress() bool {
+	if x != nil && x.Compress != nil {
+		return *x.Compress
+	}
+	return false
+}
+
+func (x *WormBytes) GetLazy() bool {
+	if x != nil && x.Lazy != nil {
+		return *x.Lazy
+	}
+	return false
+}
+
+// binds sort enum field to the model, enum values are mapped to the model columns,
+// direction is the enum of the sort direction with ASC and DESC values (SortTypes by default)
+type WormSort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         *string                `protobuf:"bytes,1,opt,name=model" json:"model,omitempty"`
+	Direction     *string                `protobuf:"bytes,2,opt,name=direction" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *WormTag) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WormTag.Unmarshal(m, b)
+func (x *WormSort) Reset() {
+	*x = WormSort{}
+	mi := &file_plugin_options_worm_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *WormTag) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WormTag.Marshal(b, m, deterministic)
+
+func (x *WormSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *WormTag) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WormTag.Merge(m, src)
+
+func (*WormSort) ProtoMessage() {}
+
+func (x *WormSort) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_options_worm_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-func (m *WormTag) XXX_Size() int {
-	return xxx_messageInfo_WormTag.Size(m)
+
+// Deprecated: Use WormSort.ProtoReflect.Descriptor instead.
+func (*WormSort) Descriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{4}
 }
-func (m *WormTag) XXX_DiscardUnknown() {
-	xxx_messageInfo_WormTag.DiscardUnknown(m)
+
+func (x *WormSort) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
 }
 
-var xxx_messageInfo_WormTag proto.InternalMessageInfo
+func (x *WormSort) GetDirection() string {
+	if x != nil && x.Direction != nil {
+		return *x.Direction
+	}
+	return ""
+}
+
+type WormTag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gorm          *string                `protobuf:"bytes,3,opt,name=gorm" json:"gorm,omitempty"`
+	Validator     *string                `protobuf:"bytes,4,opt,name=validator" json:"validator,omitempty"`
+	Jsonb         *bool                  `protobuf:"varint,5,opt,name=jsonb" json:"jsonb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WormTag) Reset() {
+	*x = WormTag{}
+	mi := &file_plugin_options_worm_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-func (m *WormTag) GetGorm() string {
-	if m != nil && m.Gorm != nil {
-		return *m.Gorm
+func (x *WormTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WormTag) ProtoMessage() {}
+
+func (x *WormTag) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_options_worm_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WormTag.ProtoReflect.Descriptor instead.
+func (*WormTag) Descriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WormTag) GetGorm() string {
+	if x != nil && x.Gorm != nil {
+		return *x.Gorm
 	}
 	return ""
 }
 
-func (m *WormTag) GetValidator() string {
-	if m != nil && m.Validator != nil {
-		return *m.Validator
+func (x *WormTag) GetValidator() string {
+	if x != nil && x.Validator != nil {
+		return *x.Validator
 	}
 	return ""
 }
 
-func (m *WormTag) GetJsonb() bool {
-	if m != nil && m.Jsonb != nil {
-		return *m.Jsonb
+func (x *WormTag) GetJsonb() bool {
+	if x != nil && x.Jsonb != nil {
+		return *x.Jsonb
 	}
 	return false
 }
 
 type Pagination struct {
-	TotalCount           *int32   `protobuf:"varint,1,req,name=totalCount" json:"totalCount,omitempty"`
-	TotalPages           *int32   `protobuf:"varint,2,req,name=totalPages" json:"totalPages,omitempty"`
-	CurrentPage          *int32   `protobuf:"varint,3,req,name=currentPage" json:"currentPage,omitempty"`
-	Size                 *int32   `protobuf:"varint,4,req,name=size" json:"size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *Pagination) Reset()         { *m = Pagination{} }
-func (m *Pagination) String() string { return proto.CompactTextString(m) }
-func (*Pagination) ProtoMessage()    {}
-func (*Pagination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c056d40fbc59afe5, []int{4}
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalCount    *int32                 `protobuf:"varint,1,req,name=totalCount" json:"totalCount,omitempty"`
+	TotalPages    *int32                 `protobuf:"varint,2,req,name=totalPages" json:"totalPages,omitempty"`
+	CurrentPage   *int32                 `protobuf:"varint,3,req,name=currentPage" json:"currentPage,omitempty"`
+	Size          *int32                 `protobuf:"varint,4,req,name=size" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *Pagination) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Pagination.Unmarshal(m, b)
-}
-func (m *Pagination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Pagination.Marshal(b, m, deterministic)
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_plugin_options_worm_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-func (m *Pagination) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Pagination.Merge(m, src)
-}
-func (m *Pagination) XXX_Size() int {
-	return xxx_messageInfo_Pagination.Size(m)
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *Pagination) XXX_DiscardUnknown() {
-	xxx_messageInfo_Pagination.DiscardUnknown(m)
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_options_worm_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_Pagination proto.InternalMessageInfo
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{6}
+}
 
-func (m *Pagination) GetTotalCount() int32 {
-	if m != nil && m.TotalCount != nil {
-		return *m.TotalCount
+func (x *Pagination) GetTotalCount() int32 {
+	if x != nil && x.TotalCount != nil {
+		return *x.TotalCount
 	}
 	return 0
 }
 
-func (m *Pagination) GetTotalPages() int32 {
-	if m != nil && m.TotalPages != nil {
-		return *m.TotalPages
+func (x *Pagination) GetTotalPages() int32 {
+	if x != nil && x.TotalPages != nil {
+		return *x.TotalPages
 	}
 	return 0
 }
 
-func (m *Pagination) GetCurrentPage() int32 {
-	if m != nil && m.CurrentPage != nil {
-		return *m.CurrentPage
+func (x *Pagination) GetCurrentPage() int32 {
+	if x != nil && x.CurrentPage != nil {
+		return *x.CurrentPage
 	}
 	return 0
 }
 
-func (m *Pagination) GetSize() int32 {
-	if m != nil && m.Size != nil {
-		return *m.Size
+func (x *Pagination) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
 	}
 	return 0
 }
 
 type AutoServerOptions struct {
-	Autogen              *bool    `protobuf:"varint,1,opt,name=autogen" json:"autogen,omitempty"`
-	TxnMiddleware        *bool    `protobuf:"varint,2,opt,name=txn_middleware,json=txnMiddleware" json:"txn_middleware,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Autogen       *bool                  `protobuf:"varint,1,opt,name=autogen" json:"autogen,omitempty"`
+	TxnMiddleware *bool                  `protobuf:"varint,2,opt,name=txn_middleware,json=txnMiddleware" json:"txn_middleware,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *AutoServerOptions) Reset()         { *m = AutoServerOptions{} }
-func (m *AutoServerOptions) String() string { return proto.CompactTextString(m) }
-func (*AutoServerOptions) ProtoMessage()    {}
-func (*AutoServerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c056d40fbc59afe5, []int{5}
+func (x *AutoServerOptions) Reset() {
+	*x = AutoServerOptions{}
+	mi := &file_plugin_options_worm_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (m *AutoServerOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AutoServerOptions.Unmarshal(m, b)
-}
-func (m *AutoServerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AutoServerOptions.Marshal(b, m, deterministic)
-}
-func (m *AutoServerOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AutoServerOptions.Merge(m, src)
+func (x *AutoServerOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *AutoServerOptions) XXX_Size() int {
-	return xxx_messageInfo_AutoServerOptions.Size(m)
-}
-func (m *AutoServerOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_AutoServerOptions.DiscardUnknown(m)
+
+func (*AutoServerOptions) ProtoMessage() {}
+
+func (x *AutoServerOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_options_worm_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_AutoServerOptions proto.InternalMessageInfo
+// Deprecated: Use AutoServerOptions.ProtoReflect.Descriptor instead.
+func (*AutoServerOptions) Descriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{7}
+}
 
-func (m *AutoServerOptions) GetAutogen() bool {
-	if m != nil && m.Autogen != nil {
-		return *m.Autogen
+func (x *AutoServerOptions) GetAutogen() bool {
+	if x != nil && x.Autogen != nil {
+		return *x.Autogen
 	}
 	return false
 }
 
-func (m *AutoServerOptions) GetTxnMiddleware() bool {
-	if m != nil && m.TxnMiddleware != nil {
-		return *m.TxnMiddleware
+func (x *AutoServerOptions) GetTxnMiddleware() bool {
+	if x != nil && x.TxnMiddleware != nil {
+		return *x.TxnMiddleware
 	}
 	return false
 }
 
 type MethodOptions struct {
-	ObjectType           *string  `protobuf:"bytes,1,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjectType    *string                `protobuf:"bytes,1,opt,name=object_type,json=objectType" json:"object_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (m *MethodOptions) Reset()         { *m = MethodOptions{} }
-func (m *MethodOptions) String() string { return proto.CompactTextString(m) }
-func (*MethodOptions) ProtoMessage()    {}
-func (*MethodOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c056d40fbc59afe5, []int{6}
+func (x *MethodOptions) Reset() {
+	*x = MethodOptions{}
+	mi := &file_plugin_options_worm_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (m *MethodOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MethodOptions.Unmarshal(m, b)
-}
-func (m *MethodOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MethodOptions.Marshal(b, m, deterministic)
+func (x *MethodOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *MethodOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MethodOptions.Merge(m, src)
-}
-func (m *MethodOptions) XXX_Size() int {
-	return xxx_messageInfo_MethodOptions.Size(m)
-}
-func (m *MethodOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_MethodOptions.DiscardUnknown(m)
+
+func (*MethodOptions) ProtoMessage() {}
+
+func (x *MethodOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_options_worm_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_MethodOptions proto.InternalMessageInfo
+// Deprecated: Use MethodOptions.ProtoReflect.Descriptor instead.
+func (*MethodOptions) Descriptor() ([]byte, []int) {
+	return file_plugin_options_worm_proto_rawDescGZIP(), []int{8}
+}
 
-func (m *MethodOptions) GetObjectType() string {
-	if m != nil && m.ObjectType != nil {
-		return *m.ObjectType
+func (x *MethodOptions) GetObjectType() string {
+	if x != nil && x.ObjectType != nil {
+		return *x.ObjectType
 	}
 	return ""
 }
 
-var E_FileOpts = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FileOptions)(nil),
-	ExtensionType: (*WormFileOptions)(nil),
-	Field:         332355,
-	Name:          "worm.file_opts",
-	Tag:           "bytes,332355,opt,name=file_opts",
-	Filename:      "plugin/options/worm.proto",
-}
-
-var E_Opts = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.MessageOptions)(nil),
-	ExtensionType: (*WormMessageOptions)(nil),
-	Field:         332355,
-	Name:          "worm.opts",
-	Tag:           "bytes,332355,opt,name=opts",
-	Filename:      "plugin/options/worm.proto",
-}
-
-var E_Field = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.FieldOptions)(nil),
-	ExtensionType: (*WormFieldOptions)(nil),
-	Field:         332355,
-	Name:          "worm.field",
-	Tag:           "bytes,332355,opt,name=field",
-	Filename:      "plugin/options/worm.proto",
-}
-
-var E_Server = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.ServiceOptions)(nil),
-	ExtensionType: (*AutoServerOptions)(nil),
-	Field:         332355,
-	Name:          "worm.server",
-	Tag:           "bytes,332355,opt,name=server",
-	Filename:      "plugin/options/worm.proto",
-}
-
-var E_Method = &proto.ExtensionDesc{
-	ExtendedType:  (*descriptor.MethodOptions)(nil),
-	ExtensionType: (*MethodOptions)(nil),
-	Field:         332355,
-	Name:          "worm.method",
-	Tag:           "bytes,332355,opt,name=method",
-	Filename:      "plugin/options/worm.proto",
-}
-
-func init() {
-	proto.RegisterType((*WormFileOptions)(nil), "worm.WormFileOptions")
-	proto.RegisterType((*WormMessageOptions)(nil), "worm.WormMessageOptions")
-	proto.RegisterType((*WormFieldOptions)(nil), "worm.WormFieldOptions")
-	proto.RegisterType((*WormTag)(nil), "worm.WormTag")
-	proto.RegisterType((*Pagination)(nil), "worm.Pagination")
-	proto.RegisterType((*AutoServerOptions)(nil), "worm.AutoServerOptions")
-	proto.RegisterType((*MethodOptions)(nil), "worm.MethodOptions")
-	proto.RegisterExtension(E_FileOpts)
-	proto.RegisterExtension(E_Opts)
-	proto.RegisterExtension(E_Field)
-	proto.RegisterExtension(E_Server)
-	proto.RegisterExtension(E_Method)
-}
-
-func init() {
-	proto.RegisterFile("plugin/options/worm.proto", fileDescriptor_c056d40fbc59afe5)
-}
-
-var fileDescriptor_c056d40fbc59afe5 = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x95, 0xdb, 0xa4, 0x75, 0xa6, 0x2a, 0xd0, 0xa5, 0xc0, 0x82, 0x4a, 0x6b, 0x59, 0x42, 0xca,
-	0x29, 0x41, 0x70, 0xcb, 0x0d, 0x81, 0xb8, 0x45, 0x2d, 0x4b, 0x24, 0x8e, 0xd5, 0x26, 0x9e, 0x2c,
-	0xae, 0x6c, 0x8f, 0xb5, 0x5e, 0xa7, 0x2d, 0x47, 0xbe, 0x85, 0x33, 0x3f, 0xc1, 0x8f, 0xa1, 0xdd,
-	0x8d, 0x63, 0x47, 0xc9, 0x6d, 0xdf, 0x9b, 0xd1, 0xdb, 0x37, 0x6f, 0x06, 0x5e, 0x97, 0x59, 0xad,
-	0xd2, 0x62, 0x4c, 0xa5, 0x49, 0xa9, 0xa8, 0xc6, 0xf7, 0xa4, 0xf3, 0x51, 0xa9, 0xc9, 0x10, 0xeb,
-	0xd9, 0xf7, 0x9b, 0x48, 0x11, 0xa9, 0x0c, 0xc7, 0x8e, 0x9b, 0xd7, 0xcb, 0x71, 0x82, 0xd5, 0x42,
-	0xa7, 0xa5, 0x21, 0xed, 0xfb, 0xe2, 0x33, 0x78, 0xfa, 0x83, 0x74, 0xfe, 0x35, 0xcd, 0xf0, 0xda,
-	0xab, 0xc4, 0x7f, 0x03, 0x60, 0x96, 0x9b, 0x62, 0x55, 0x49, 0xd5, 0xd0, 0xec, 0x1c, 0xfa, 0x39,
-	0x25, 0x98, 0xf1, 0x20, 0x3a, 0x18, 0x86, 0xc2, 0x03, 0xcb, 0x1a, 0x39, 0xcf, 0x90, 0x1f, 0x44,
-	0xc1, 0x70, 0x20, 0x3c, 0x70, 0xbd, 0xa8, 0x15, 0xf2, 0x9e, 0x67, 0x1d, 0x60, 0x1c, 0x8e, 0xf3,
-	0x54, 0x69, 0x69, 0x90, 0x1f, 0x46, 0xc1, 0x30, 0x14, 0x0d, 0x64, 0x97, 0x00, 0x15, 0x2d, 0xcd,
-	0x17, 0xcc, 0xd0, 0x20, 0x3f, 0x72, 0xc5, 0x0e, 0xc3, 0x2e, 0x60, 0xb0, 0xa0, 0x62, 0x85, 0xda,
-	0xcc, 0x88, 0xf7, 0x9d, 0x66, 0x4b, 0xc4, 0x1f, 0xe1, 0x99, 0x9f, 0x01, 0xb3, 0xa4, 0x71, 0x7b,
-	0x05, 0x87, 0x46, 0x2a, 0x1e, 0x44, 0xc1, 0xf0, 0xe4, 0xc3, 0xe9, 0xc8, 0x25, 0x63, 0x9b, 0x66,
-	0x52, 0x09, 0x5b, 0x89, 0xbf, 0xc1, 0xf1, 0x1a, 0x33, 0x06, 0x3d, 0x45, 0x3a, 0x77, 0xa6, 0x06,
-	0xc2, 0xbd, 0xed, 0x8f, 0x2b, 0x99, 0xa5, 0x89, 0x34, 0xa4, 0xd7, 0x53, 0xb4, 0x84, 0x9d, 0xef,
-	0xae, 0xa2, 0x62, 0xee, 0xbc, 0x84, 0xc2, 0x83, 0xf8, 0x77, 0x00, 0x70, 0x23, 0x55, 0x5a, 0x48,
-	0xeb, 0xc1, 0x0e, 0x65, 0xc8, 0xc8, 0xec, 0x33, 0xd5, 0x85, 0x71, 0xa9, 0xf5, 0x45, 0x87, 0xd9,
-	0xd4, 0x6f, 0xa4, 0xc2, 0x8a, 0x1f, 0x74, 0xea, 0x8e, 0x61, 0x11, 0x9c, 0x2c, 0x6a, 0xad, 0xb1,
-	0x30, 0x16, 0xf3, 0x43, 0xd7, 0xd0, 0xa5, 0xac, 0xf1, 0x2a, 0xfd, 0x65, 0x53, 0xb6, 0x25, 0xf7,
-	0x8e, 0x67, 0x70, 0xf6, 0xa9, 0x36, 0xf4, 0x1d, 0xf5, 0x0a, 0x75, 0x93, 0x06, 0x87, 0x63, 0x59,
-	0x1b, 0x52, 0x58, 0xb8, 0x44, 0x42, 0xd1, 0x40, 0xf6, 0x0e, 0x9e, 0x98, 0x87, 0xe2, 0x36, 0x4f,
-	0x93, 0x24, 0xc3, 0x7b, 0xa9, 0xfd, 0x22, 0x43, 0x71, 0x6a, 0x1e, 0x8a, 0xe9, 0x86, 0x8c, 0xdf,
-	0xc3, 0xe9, 0x14, 0xcd, 0x4f, 0xea, 0xe4, 0x7b, 0x42, 0xf3, 0x3b, 0x5c, 0x98, 0x5b, 0xf3, 0x58,
-	0xa2, 0x53, 0x1d, 0x08, 0xf0, 0xd4, 0xec, 0xb1, 0xc4, 0x89, 0x80, 0xc1, 0x32, 0xcd, 0xf0, 0x96,
-	0x4a, 0x53, 0xb1, 0x8b, 0x91, 0x3f, 0xc4, 0x51, 0x73, 0x88, 0xa3, 0xce, 0xc1, 0xf1, 0x7f, 0x7f,
-	0xce, 0xdd, 0x9a, 0x5e, 0xb4, 0x6b, 0xea, 0x94, 0x45, 0xb8, 0xf4, 0xa0, 0x9a, 0x5c, 0x43, 0xcf,
-	0xc9, 0x5d, 0xed, 0xc8, 0x6d, 0xdf, 0xea, 0x46, 0x91, 0xb7, 0x8a, 0xdb, 0x1d, 0xc2, 0x09, 0x4d,
-	0xa6, 0xd0, 0x5f, 0xda, 0xab, 0x61, 0x6f, 0xf7, 0x18, 0x6c, 0xaf, 0x69, 0xa3, 0xf7, 0xb2, 0xeb,
-	0xb0, 0xad, 0x0b, 0xaf, 0x32, 0x11, 0x70, 0x54, 0xb9, 0xdc, 0xf7, 0x38, 0xb4, 0x0b, 0x49, 0x17,
-	0x3b, 0x0e, 0x5f, 0x79, 0xc5, 0x9d, 0x95, 0x89, 0xb5, 0xd2, 0x64, 0x0a, 0x47, 0xb9, 0x4b, 0x9e,
-	0x5d, 0xee, 0x99, 0xba, 0xb3, 0x92, 0x8d, 0xe4, 0x73, 0x2f, 0xb9, 0x55, 0x14, 0x6b, 0x91, 0xff,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x03, 0x06, 0xa0, 0x58, 0x33, 0x04, 0x00, 0x00,
+var file_plugin_options_worm_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*WormFileOptions)(nil),
+		Field:         332355,
+		Name:          "worm.file_opts",
+		Tag:           "bytes,332355,opt,name=file_opts",
+		Filename:      "plugin/options/worm.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*WormMessageOptions)(nil),
+		Field:         332355,
+		Name:          "worm.opts",
+		Tag:           "bytes,332355,opt,name=opts",
+		Filename:      "plugin/options/worm.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*WormFieldOptions)(nil),
+		Field:         332355,
+		Name:          "worm.field",
+		Tag:           "bytes,332355,opt,name=field",
+		Filename:      "plugin/options/worm.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*AutoServerOptions)(nil),
+		Field:         332355,
+		Name:          "worm.server",
+		Tag:           "bytes,332355,opt,name=server",
+		Filename:      "plugin/options/worm.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*MethodOptions)(nil),
+		Field:         332355,
+		Name:          "worm.method",
+		Tag:           "bytes,332355,opt,name=method",
+		Filename:      "plugin/options/worm.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional worm.WormFileOptions file_opts = 332355;
+	E_FileOpts = &file_plugin_options_worm_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional worm.WormMessageOptions opts = 332355;
+	E_Opts = &file_plugin_options_worm_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional worm.WormFieldOptions field = 332355;
+	E_Field = &file_plugin_options_worm_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.ServiceOptions.
+var (
+	// optional worm.AutoServerOptions server = 332355;
+	E_Server = &file_plugin_options_worm_proto_extTypes[3]
+)
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional worm.MethodOptions method = 332355;
+	E_Method = &file_plugin_options_worm_proto_extTypes[4]
+)
+
+var File_plugin_options_worm_proto protoreflect.FileDescriptor
+
+const file_plugin_options_worm_proto_rawDesc = "" +
+	"\n" +
+	"\x19plugin/options/worm.proto\x12\x04worm\x1a google/protobuf/descriptor.proto\"G\n" +
+	"\x0fWormFileOptions\x124\n" +
+	"\fenum_storage\x18\x01 \x01(\x0e2\x11.worm.EnumStorageR\venumStorage\"\xae\x01\n" +
+	"\x12WormMessageOptions\x12\x14\n" +
+	"\x05model\x18\x01 \x02(\bR\x05model\x12\x14\n" +
+	"\x05table\x18\x02 \x01(\tR\x05table\x12\x14\n" +
+	"\x05merge\x18\x04 \x01(\tR\x05merge\x12\x18\n" +
+	"\amigrate\x18\x03 \x01(\bR\amigrate\x12\x1e\n" +
+	"\n" +
+	"softDelete\x18\x06 \x01(\bR\n" +
+	"softDelete\x12\x1c\n" +
+	"\tconvertTo\x18\x05 \x01(\tR\tconvertTo\"\xb4\x01\n" +
+	"\x10WormFieldOptions\x12\x1f\n" +
+	"\x03tag\x18\x01 \x01(\v2\r.worm.WormTagR\x03tag\x12\"\n" +
+	"\x04sort\x18\x02 \x01(\v2\x0e.worm.WormSortR\x04sort\x124\n" +
+	"\fenum_storage\x18\x03 \x01(\x0e2\x11.worm.EnumStorageR\venumStorage\x12%\n" +
+	"\x05bytes\x18\x04 \x01(\v2\x0f.worm.WormBytesR\x05bytes\";\n" +
+	"\tWormBytes\x12\x1a\n" +
+	"\bcompress\x18\x01 \x01(\bR\bcompress\x12\x12\n" +
+	"\x04lazy\x18\x02 \x01(\bR\x04lazy\">\n" +
+	"\bWormSort\x12\x14\n" +
+	"\x05model\x18\x01 \x01(\tR\x05model\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\"Q\n" +
+	"\aWormTag\x12\x12\n" +
+	"\x04gorm\x18\x03 \x01(\tR\x04gorm\x12\x1c\n" +
+	"\tvalidator\x18\x04 \x01(\tR\tvalidator\x12\x14\n" +
+	"\x05jsonb\x18\x05 \x01(\bR\x05jsonb\"\x82\x01\n" +
+	"\n" +
+	"Pagination\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x02(\x05R\n" +
+	"totalCount\x12\x1e\n" +
+	"\n" +
+	"totalPages\x18\x02 \x02(\x05R\n" +
+	"totalPages\x12 \n" +
+	"\vcurrentPage\x18\x03 \x02(\x05R\vcurrentPage\x12\x12\n" +
+	"\x04size\x18\x04 \x02(\x05R\x04size\"T\n" +
+	"\x11AutoServerOptions\x12\x18\n" +
+	"\aautogen\x18\x01 \x01(\bR\aautogen\x12%\n" +
+	"\x0etxn_middleware\x18\x02 \x01(\bR\rtxnMiddleware\"0\n" +
+	"\rMethodOptions\x12\x1f\n" +
+	"\vobject_type\x18\x01 \x01(\tR\n" +
+	"objectType*U\n" +
+	"\vEnumStorage\x12\x14\n" +
+	"\x10ENUM_STORAGE_INT\x10\x00\x12\x17\n" +
+	"\x13ENUM_STORAGE_STRING\x10\x01\x12\x17\n" +
+	"\x13ENUM_STORAGE_NATIVE\x10\x02:R\n" +
+	"\tfile_opts\x12\x1c.google.protobuf.FileOptions\x18ä\x14 \x01(\v2\x15.worm.WormFileOptionsR\bfileOpts:O\n" +
+	"\x04opts\x12\x1f.google.protobuf.MessageOptions\x18ä\x14 \x01(\v2\x18.worm.WormMessageOptionsR\x04opts:M\n" +
+	"\x05field\x12\x1d.google.protobuf.FieldOptions\x18ä\x14 \x01(\v2\x16.worm.WormFieldOptionsR\x05field:R\n" +
+	"\x06server\x12\x1f.google.protobuf.ServiceOptions\x18ä\x14 \x01(\v2\x17.worm.AutoServerOptionsR\x06server:M\n" +
+	"\x06method\x12\x1e.google.protobuf.MethodOptions\x18ä\x14 \x01(\v2\x13.worm.MethodOptionsR\x06methodB8Z6github.com/cjp2600/protoc-gen-worm/plugin/options;worm"
+
+var (
+	file_plugin_options_worm_proto_rawDescOnce sync.Once
+	file_plugin_options_worm_proto_rawDescData []byte
+)
+
+func file_plugin_options_worm_proto_rawDescGZIP() []byte {
+	file_plugin_options_worm_proto_rawDescOnce.Do(func() {
+		file_plugin_options_worm_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugin_options_worm_proto_rawDesc), len(file_plugin_options_worm_proto_rawDesc)))
+	})
+	return file_plugin_options_worm_proto_rawDescData
+}
+
+var file_plugin_options_worm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_plugin_options_worm_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_plugin_options_worm_proto_goTypes = []any{
+	(EnumStorage)(0),                    // 0: worm.EnumStorage
+	(*WormFileOptions)(nil),             // 1: worm.WormFileOptions
+	(*WormMessageOptions)(nil),          // 2: worm.WormMessageOptions
+	(*WormFieldOptions)(nil),            // 3: worm.WormFieldOptions
+	(*WormBytes)(nil),                   // 4: worm.WormBytes
+	(*WormSort)(nil),                    // 5: worm.WormSort
+	(*WormTag)(nil),                     // 6: worm.WormTag
+	(*Pagination)(nil),                  // 7: worm.Pagination
+	(*AutoServerOptions)(nil),           // 8: worm.AutoServerOptions
+	(*MethodOptions)(nil),               // 9: worm.MethodOptions
+	(*descriptorpb.FileOptions)(nil),    // 10: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil), // 11: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),   // 12: google.protobuf.FieldOptions
+	(*descriptorpb.ServiceOptions)(nil), // 13: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 14: google.protobuf.MethodOptions
+}
+var file_plugin_options_worm_proto_depIdxs = []int32{
+	0,  // 0: worm.WormFileOptions.enum_storage:type_name -> worm.EnumStorage
+	6,  // 1: worm.WormFieldOptions.tag:type_name -> worm.WormTag
+	5,  // 2: worm.WormFieldOptions.sort:type_name -> worm.WormSort
+	0,  // 3: worm.WormFieldOptions.enum_storage:type_name -> worm.EnumStorage
+	4,  // 4: worm.WormFieldOptions.bytes:type_name -> worm.WormBytes
+	10, // 5: worm.file_opts:extendee -> google.protobuf.FileOptions
+	11, // 6: worm.opts:extendee -> google.protobuf.MessageOptions
+	12, // 7: worm.field:extendee -> google.protobuf.FieldOptions
+	13, // 8: worm.server:extendee -> google.protobuf.ServiceOptions
+	14, // 9: worm.method:extendee -> google.protobuf.MethodOptions
+	1,  // 10: worm.file_opts:type_name -> worm.WormFileOptions
+	2,  // 11: worm.opts:type_name -> worm.WormMessageOptions
+	3,  // 12: worm.field:type_name -> worm.WormFieldOptions
+	8,  // 13: worm.server:type_name -> worm.AutoServerOptions
+	9,  // 14: worm.method:type_name -> worm.MethodOptions
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	10, // [10:15] is the sub-list for extension type_name
+	5,  // [5:10] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_plugin_options_worm_proto_init() }
+func file_plugin_options_worm_proto_init() {
+	if File_plugin_options_worm_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugin_options_worm_proto_rawDesc), len(file_plugin_options_worm_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   9,
+			NumExtensions: 5,
+			NumServices:   0,
+		},
+		GoTypes:           file_plugin_options_worm_proto_goTypes,
+		DependencyIndexes: file_plugin_options_worm_proto_depIdxs,
+		EnumInfos:         file_plugin_options_worm_proto_enumTypes,
+		MessageInfos:      file_plugin_options_worm_proto_msgTypes,
+		ExtensionInfos:    file_plugin_options_worm_proto_extTypes,
+	}.Build()
+	File_plugin_options_worm_proto = out.File
+	file_plugin_options_worm_proto_goTypes = nil
+	file_plugin_options_worm_proto_depIdxs = nil
 }
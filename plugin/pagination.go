@@ -0,0 +1,131 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// fields of the worm.Pagination message
+var paginationFields = []string{"totalCount", "totalPages", "currentPage", "size"}
+
+const wormPaginationType = ".worm.Pagination"
+
+func (w *WormPlugin) pageBoundsName() string {
+	return w.privateNameWithServicePrefix("PageBounds")
+}
+
+func (w *WormPlugin) newPaginationName() string {
+	return w.privateNameWithServicePrefix("NewPagination")
+}
+
+// generatePaginationHelpers - page size limits and worm.Pagination constructor shared by the file models
+func (w *WormPlugin) generatePaginationHelpers() {
+	w.useWorm = true
+	defaultSize := w.nameWithServicePrefix("DefaultPageSize")
+	maxSize := w.nameWithServicePrefix("MaxPageSize")
+
+	w.P()
+	w.P(`// `, defaultSize, ` - page size used when the requested size is not set`)
+	w.P(`var `, defaultSize, ` int32 = `, w.DefaultPageSize)
+	w.P()
+	w.P(`// `, maxSize, ` - upper bound of the requested page size`)
+	w.P(`var `, maxSize, ` int32 = `, w.MaxPageSize)
+	w.P()
+	w.P(`// `, w.pageBoundsName(), ` - normalize requested page and size, the page is clamped so its offset does not overflow`)
+	w.P(`func `, w.pageBoundsName(), `(page, size int32) (int32, int32) {`)
+	w.P(`if page < 1 {`)
+	w.P(`page = 1`)
+	w.P(`}`)
+	w.P(`if size < 1 {`)
+	w.P(`size = `, defaultSize)
+	w.P(`}`)
+	w.P(`if size > `, maxSize, ` {`)
+	w.P(`size = `, maxSize)
+	w.P(`}`)
+	w.P(`// the offset of the last page fits int32`)
+	w.P(`if maxPage := (1<<31 - 1) / size; page > maxPage {`)
+	w.P(`page = maxPage`)
+	w.P(`}`)
+	w.P(`return page, size`)
+	w.P(`}`)
+	w.P()
+	w.P(`// `, w.newPaginationName(), ` - pagination info of the page`)
+	w.P(`func `, w.newPaginationName(), `(count int64, page, size int32) *worm.Pagination {`)
+	w.P(`totalPages := int32((count + int64(size) - 1) / int64(size))`)
+	w.P(`return &worm.Pagination{`)
+	w.P(`TotalCount:  proto.Int32(int32(count)),`)
+	w.P(`TotalPages:  proto.Int32(totalPages),`)
+	w.P(`CurrentPage: proto.Int32(page),`)
+	w.P(`Size:        proto.Int32(size),`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+}
+
+// generatePaginateMethod - paginated query of the model
+func (w *WormPlugin) generatePaginateMethod(message *protogen.Message) {
+	mName := w.modelName(message)
+	paginate := w.crudMethodName(message, "Paginate")
+
+	w.P(`// `, paginate, ` - page of `, mName, ` records with the filled pagination info`)
+	w.P(`func (e *`, mName, `) `, paginate, `(ctx context.Context, page, size int32) ([]*`, mName, `, *worm.Pagination, error) {`)
+	w.P(`page, size = `, w.pageBoundsName(), `(page, size)`)
+	w.P(`var count int64`)
+	w.P(`if err := e.dbContext(ctx).Model(&`, mName, `{}).Count(&count).Error; err != nil {`)
+	w.P(`return nil, nil, err`)
+	w.P(`}`)
+	w.P(`var items []*`, mName)
+	w.P(`if err := e.dbContext(ctx).Offset((int(page) - 1) * int(size)).Limit(int(size)).Find(&items).Error; err != nil {`)
+	w.P(`return nil, nil, err`)
+	w.P(`}`)
+	w.P(`return items, `, w.newPaginationName(), `(count, page, size), nil`)
+	w.P(`}`)
+	w.P()
+}
+
+// findPaginationField - pagination field of the response, worm.Pagination or a message of the same shape
+func (w *WormPlugin) findPaginationField(message *protogen.Message) *protogen.Field {
+	for _, field := range message.Fields {
+		if isMessage(field) && !isRepeated(field) && strings.ToLower(string(field.Desc.Name())) == "pagination" {
+			return field
+		}
+	}
+	return nil
+}
+
+// findScalarField - field of the message by the case insensitive name
+func (w *WormPlugin) findScalarField(message *protogen.Message, name string) *protogen.Field {
+	for _, field := range message.Fields {
+		if isScalar(field) && !isRepeated(field) && strings.EqualFold(string(field.Desc.Name()), name) {
+			return field
+		}
+	}
+	return nil
+}
+
+// paginationValue - expression converting worm.Pagination variable to the response pagination field type
+func (w *WormPlugin) paginationValue(field *protogen.Field, variable string) string {
+	if typeName(field) == wormPaginationType {
+		return variable
+	}
+	target := field.Message
+	var values []string
+	for _, name := range paginationFields {
+		f := w.findScalarField(target, name)
+		if f == nil {
+			continue
+		}
+		value := variable + `.Get` + camelCase(name) + `()`
+		switch goType := w.goType(f); {
+		case goType == "int32":
+		case isNumeric(f) && !strings.HasPrefix(goType, "*"):
+			value = goType + `(` + value + `)`
+		default:
+			w.Fail(fmt.Sprintf("pagination field %s.%s of type %s is not a number", target.Desc.Name(), f.Desc.Name(), goType))
+		}
+		values = append(values, f.GoName+`: `+value)
+	}
+	return `&` + w.goIdent(target.GoIdent) + `{` + strings.Join(values, ", ") + `}`
+}
@@ -1,74 +1,172 @@
 package plugin
 
 import (
+	"bytes"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode"
 
-	"github.com/gogo/protobuf/gogoproto"
-	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
-	"github.com/gogo/protobuf/protoc-gen-gogo/generator"
 	"github.com/serenize/snaker"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 
 	worm "github.com/cjp2600/protoc-gen-worm/plugin/options"
 )
 
 type WormPlugin struct {
-	*generator.Generator
-	generator.PluginImports
+	Config
+	gen *protogen.Plugin
 
-	EmptyFiles      []string
-	currentPackage  string
-	currentFile     *generator.FileDescriptor
+	// body of the generated file, imports are printed before it once the body is complete
+	out      bytes.Buffer
+	imports  map[string]string
+	packages map[protogen.GoImportPath]protogen.GoPackageName
+
+	currentFile     *protogen.File
 	Entities        []string
+	Models          []string
 	PrivateEntities map[string]PrivateEntity
 	ConvertEntities map[string]ConvertEntity
-	Fields          map[string][]*descriptor.FieldDescriptorProto
+	Fields          map[string][]*protogen.Field
 	JsonBFields     map[string]JsonBField
+	SortEnums       map[string]SortEnum
+	Tables          []*SchemaTable
+	Enums           []*SchemaEnum
+	Snapshot        *Schema
+	// model types of the stored enums by the go package, the files of one package share them
+	EnumTypes map[protogen.GoImportPath]map[string]bool
 
 	clientGlobalVar   string
 	connectMethodName string
 
-	// build options
-	Migrate   bool
-	DBDriver  string
-	SSLMode   bool
-	localName string
-	useTime   bool
-	useJsonb  bool
-	useUnsafe bool
+	Migrate      bool
+	useTime      bool
+	useTimestamp bool
+	useDuration  bool
+	useJsonb     bool
+	useJson      bool
+	useServer    bool
+	useGrpc      bool
+	useTxn       bool
+	useCtx       bool
+	useWorm      bool
+	useURL       bool
+
+	useGormConfig bool
+	useFieldMask  bool
+	useWrappers   bool
+	useStrings    bool
+	useProtoJSON  bool
+	useStruct     bool
+	useAny        bool
+	useDriver     bool
+	useCompress   bool
+	useGormSchema bool
 }
 
 type JsonBField struct {
 	name string
-	tps  string
-	fld  *descriptor.FieldDescriptorProto
+	kind protoreflect.Kind
+	fld  *protogen.Field
 }
 
 type ConvertEntity struct {
 	nameFrom string
 	nameTo   string
 	isOneOf  bool
-	message  *generator.Descriptor
+	message  *protogen.Message
 }
 
 type PrivateEntity struct {
 	name    string
 	isOneOf bool
-	items   []*descriptor.FieldDescriptorProto
-	message *generator.Descriptor
+	items   []*protogen.Field
+	message *protogen.Message
+}
+
+// failure - error of the generation, Fail panics with it and Run returns it to protoc
+type failure string
+
+func (f failure) Error() string {
+	return string(f)
 }
 
 var ServiceName string
 
-func NewWormPlugin(generator *generator.Generator) *WormPlugin {
-	return &WormPlugin{Generator: generator}
+func NewWormPlugin() *WormPlugin {
+	return &WormPlugin{}
+}
+
+// Run - generate the models of the requested files, migrations of all models are written after them
+func (w *WormPlugin) Run(gen *protogen.Plugin) (err error) {
+	gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL |
+		pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+	gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+	gen.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
+
+	defer func() {
+		if r := recover(); r != nil {
+			f, ok := r.(failure)
+			if !ok {
+				panic(r)
+			}
+			err = f
+		}
+	}()
+
+	w.Init(gen)
+	for _, file := range gen.Files {
+		if file.Generate {
+			w.Generate(file)
+		}
+	}
+	w.MigrationFiles()
+	return nil
+}
+
+// Fail - stop the generation, the error is reported by protoc
+func (w *WormPlugin) Fail(msgs ...string) {
+	panic(failure(strings.Join(msgs, " ")))
+}
+
+// P - print the line of the generated file
+func (w *WormPlugin) P(str ...interface{}) {
+	for _, v := range str {
+		fmt.Fprint(&w.out, v)
+	}
+	w.out.WriteByte('\n')
+}
+
+// PrintImport - import of the generated file
+func (w *WormPlugin) PrintImport(name, importPath string) {
+	w.imports[importPath] = name
+}
+
+// writeFile - extra output file (migrations, schema snapshot), the name is relative to the output directory
+func (w *WormPlugin) writeFile(name, content string) {
+	// protogen strips the module prefix from the names of all files when module= is set
+	if len(w.GoModule) > 0 {
+		name = path.Join(w.GoModule, name)
+	}
+	g := w.gen.NewGeneratedFile(name, "")
+	if _, err := g.Write([]byte(content)); err != nil {
+		w.Fail(fmt.Sprintf("write %s: %v", name, err))
+	}
 }
 
 func (w *WormPlugin) GetDBDriver() string {
 	if len(w.DBDriver) > 0 {
-		return strings.ToLower(w.DBDriver)
+		return w.DBDriver
 	}
 	return "postgres"
 }
@@ -76,13 +174,13 @@ func (w *WormPlugin) GetDBDriver() string {
 func (w *WormPlugin) DBDriverImport() {
 	switch w.GetDBDriver() {
 	case "postgres":
-		w.Generator.PrintImport("postgres", "gorm.io/driver/postgres")
+		w.PrintImport("postgres", "gorm.io/driver/postgres")
 	case "mysql":
-		w.Generator.PrintImport("mysql", "gorm.io/driver/mysql")
-	case "mssql":
-		w.Generator.PrintImport("mssql", "gorm.io/driver/mssql")
+		w.PrintImport("mysql", "gorm.io/driver/mysql")
+	case "mssql", "sqlserver":
+		w.PrintImport("sqlserver", "gorm.io/driver/sqlserver")
 	case "sqlite":
-		w.Generator.PrintImport("sqlite", "gorm.io/driver/sqlite")
+		w.PrintImport("sqlite", "gorm.io/driver/sqlite")
 	}
 }
 
@@ -90,6 +188,16 @@ func (w *WormPlugin) generateModelName(name string) string {
 	return name + "WORM"
 }
 
+// messageName - go name of the protobuf message, nested messages are prefixed with the parents (User_Address)
+func (w *WormPlugin) messageName(message *protogen.Message) string {
+	return message.GoIdent.GoName
+}
+
+// modelName - model of the protobuf message (User_AddressWORM)
+func (w *WormPlugin) modelName(message *protogen.Message) string {
+	return w.generateModelName(w.messageName(message))
+}
+
 func (w *WormPlugin) nameWithServicePrefix(funcName string) string {
 	return ServiceName + funcName
 }
@@ -102,58 +210,199 @@ func (w *WormPlugin) privateName(funcName string) string {
 	return strings.ToLower(funcName)
 }
 
-func (w *WormPlugin) Name() string {
-	return "worm"
-}
-
-func (w *WormPlugin) GenerateImports(file *generator.FileDescriptor) {
-	w.Generator.PrintImport("errors", "errors")
-	w.Generator.PrintImport("redis", "github.com/go-redis/redis")
-	w.Generator.PrintImport("os", "os")
-	w.Generator.PrintImport("gorm", "gorm.io/gorm")
-	w.Generator.PrintImport("valid", "github.com/asaskevich/govalidator")
+func (w *WormPlugin) GenerateImports(file *protogen.File) {
+	w.PrintImport("errors", "errors")
+	w.PrintImport("fmt", "fmt")
+	w.PrintImport("redis", "github.com/go-redis/redis")
+	w.PrintImport("os", "os")
+	w.PrintImport("gorm", "gorm.io/gorm")
+	w.PrintImport("valid", "github.com/asaskevich/govalidator")
 	if w.useTime {
-		w.Generator.PrintImport("time", "time")
-		w.Generator.PrintImport("ptypes", "github.com/golang/protobuf/ptypes")
+		w.PrintImport("time", "time")
+	}
+	if w.useTimestamp {
+		w.PrintImport("timestamppb", "google.golang.org/protobuf/types/known/timestamppb")
+	}
+	if w.useDuration {
+		w.PrintImport("durationpb", "google.golang.org/protobuf/types/known/durationpb")
+	}
+	if w.useJsonb || w.useJson {
+		w.PrintImport("jsoniter", "github.com/json-iterator/go")
 	}
 	if w.useJsonb {
-		w.Generator.PrintImport("jsoniter", "github.com/json-iterator/go")
-		w.Generator.PrintImport("datatypes", "gorm.io/datatypes")
+		w.PrintImport("datatypes", "gorm.io/datatypes")
+	}
+	if w.useCtx || w.useServer {
+		w.PrintImport("context", "context")
+	}
+	if w.useServer {
+		w.PrintImport("codes", "google.golang.org/grpc/codes")
+		w.PrintImport("status", "google.golang.org/grpc/status")
+	}
+	if w.useGrpc {
+		w.PrintImport("grpc", "google.golang.org/grpc")
+	}
+	if w.useGormConfig {
+		w.PrintImport("logger", "gorm.io/gorm/logger")
+	}
+	if w.useGormConfig || w.useGormSchema {
+		w.PrintImport("schema", "gorm.io/gorm/schema")
+	}
+	if w.useURL {
+		w.PrintImport("url", "net/url")
+	}
+	if w.useStrings {
+		w.PrintImport("strings", "strings")
+	}
+	if w.useProtoJSON {
+		w.PrintImport("protojson", "google.golang.org/protobuf/encoding/protojson")
+	}
+	if w.useStruct {
+		w.PrintImport("structpb", "google.golang.org/protobuf/types/known/structpb")
+	}
+	if w.useAny {
+		w.PrintImport("anypb", "google.golang.org/protobuf/types/known/anypb")
 	}
-	if w.useUnsafe {
-		w.Generator.PrintImport("unsafe", "unsafe")
+	if w.useWrappers {
+		w.PrintImport("wrapperspb", "google.golang.org/protobuf/types/known/wrapperspb")
+	}
+	if w.useFieldMask {
+		w.PrintImport("fieldmaskpb", "google.golang.org/protobuf/types/known/fieldmaskpb")
+	}
+	if w.useDriver {
+		w.PrintImport("driver", "database/sql/driver")
+	}
+	if w.useCompress {
+		w.PrintImport("bytes", "bytes")
+		w.PrintImport("gzip", "compress/gzip")
+		w.PrintImport("ioutil", "io/ioutil")
+	}
+	if w.useWorm {
+		w.PrintImport("worm", "github.com/cjp2600/protoc-gen-worm/plugin/options")
+		w.PrintImport("proto", "google.golang.org/protobuf/proto")
 	}
 	w.DBDriverImport()
+	// packages of the messages declared in the other go packages
+	used := usedPackages(w.out.Bytes())
+	for importPath, name := range w.packages {
+		if used == nil || used[string(name)] {
+			w.PrintImport(string(name), string(importPath))
+		}
+	}
 }
 
-func (w *WormPlugin) Init(gen *generator.Generator) {
-	generator.RegisterPlugin(NewWormPlugin(gen))
-	w.Generator = gen
+// reservedImports - names of the imports of the generated files, message packages are renamed to not conflict with them
+var reservedImports = map[string]bool{
+	"errors": true, "fmt": true, "redis": true, "os": true, "gorm": true, "valid": true, "time": true,
+	"timestamppb": true, "durationpb": true, "jsoniter": true, "datatypes": true, "context": true,
+	"codes": true, "status": true, "grpc": true, "logger": true, "schema": true, "url": true,
+	"strings": true, "protojson": true, "structpb": true, "anypb": true, "wrapperspb": true,
+	"fieldmaskpb": true, "driver": true, "bytes": true, "gzip": true, "ioutil": true, "worm": true,
+	"proto": true, "postgres": true, "mysql": true, "sqlserver": true, "sqlite": true,
+}
 
-	if val, ok := gen.Param["SSLMode"]; ok {
-		if val == "true" {
-			w.SSLMode = true
+// usedPackages - names of the packages referenced by the generated body, nil when it can not be parsed
+func usedPackages(body []byte) map[string]bool {
+	f, err := parser.ParseFile(token.NewFileSet(), "", append([]byte("package p\n"), body...), 0)
+	if err != nil {
+		return nil
+	}
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
 		}
+		return true
+	})
+	return used
+}
+
+// writeGoFile - header and imports of the .pb.worm.go file followed by the generated body
+func (w *WormPlugin) writeGoFile(file *protogen.File) {
+	w.imports = make(map[string]string)
+	w.GenerateImports(file)
+	paths := make([]string, 0, len(w.imports))
+	for importPath := range w.imports {
+		paths = append(paths, importPath)
+	}
+	sort.Strings(paths)
+
+	g := w.gen.NewGeneratedFile(file.GeneratedFilenamePrefix+w.Suffix, file.GoImportPath)
+	g.P("// Code generated by protoc-gen-worm. DO NOT EDIT.")
+	g.P("// source: ", file.Desc.Path())
+	g.P()
+	g.P("package ", file.GoPackageName)
+	g.P()
+	g.P("import (")
+	for _, importPath := range paths {
+		g.P(w.imports[importPath], " ", strconv.Quote(importPath))
+	}
+	g.P(")")
+	g.P()
+	if _, err := g.Write(w.out.Bytes()); err != nil {
+		w.Fail(fmt.Sprintf("write %s: %v", file.Desc.Path(), err))
+	}
+}
+
+func (w *WormPlugin) Init(gen *protogen.Plugin) {
+	w.gen = gen
+
+	// paths and M mappings are handled by protogen, they are not read through protogen ParamFunc
+	// because it treats every parameter starting with M as an import mapping (Migrations)
+	cfg, err := ParseConfig(gen.Request.GetParameter())
+	if err != nil {
+		w.Fail(err.Error())
 	}
+	w.Config = cfg
+	w.EnumTypes = make(map[protogen.GoImportPath]map[string]bool)
 
-	if val, ok := gen.Param["DBDriver"]; ok {
-		w.DBDriver = val
+	// schema diff mode, migrations are built against the stored snapshot
+	if len(w.SchemaSnapshot) > 0 {
+		w.Snapshot = w.readSchemaSnapshot(filepath.Join(w.SchemaDir, w.SchemaSnapshot))
 	}
 }
 
-func (w *WormPlugin) Generate(file *generator.FileDescriptor) {
+func (w *WormPlugin) Generate(file *protogen.File) {
+	// models of the file, the schema tables and enums of the migrations cover all the files of the request
+	w.Entities = nil
 	w.PrivateEntities = make(map[string]PrivateEntity)
 	w.ConvertEntities = make(map[string]ConvertEntity)
 	w.JsonBFields = make(map[string]JsonBField)
-	w.Fields = make(map[string][]*descriptor.FieldDescriptorProto)
+	w.Fields = make(map[string][]*protogen.Field)
+	w.SortEnums = make(map[string]SortEnum)
+	w.Models = nil
 
-	w.localName = generator.FileName(file)
+	w.resetImports()
+	w.out.Reset()
+	w.packages = make(map[protogen.GoImportPath]protogen.GoPackageName)
+
+	w.currentFile = file
 	ServiceName = w.GetServiceName(file)
+	w.useTxn = w.hasTxnMiddleware(file)
+	w.setSortEnums(file)
 	w.generateGlobalVariables()
 	w.generateRedisConnection()
+	if w.useTxn {
+		w.generateTxnMiddleware()
+	}
+	if w.hasModels(file) {
+		w.generateListOptions()
+		w.generatePaginationHelpers()
+		w.generateUpdateMaskError()
+	}
+	w.generateEnumTypes(file)
+	w.generateCompressedBytes(file)
+	w.generateWellKnownJSONTypes(file)
+	w.generateOneOfJSONTypes(file)
 	// generate structures
-	for _, msg := range file.Messages() {
-		name := w.generateModelName(msg.GetName())
+	for _, msg := range fileMessages(file) {
+		// map entries are model maps, not models
+		if msg.Desc.IsMapEntry() {
+			continue
+		}
+		name := w.modelName(msg)
 
 		w.setJsonBFields(file)
 		w.setCovertEntities(msg, name)
@@ -166,48 +415,87 @@ func (w *WormPlugin) Generate(file *generator.FileDescriptor) {
 				w.toPB(msg)
 				w.toGorm(msg)
 				w.GenerateTableName(msg)
+				w.generateCrudMethods(msg)
+				w.generateLazyBytes(msg)
+				w.generatePaginateMethod(msg)
+				w.generateSortMethod(msg)
+				w.generateCacheMethods(msg)
+				w.Models = append(w.Models, w.messageName(msg))
 				if wormMessage.GetMigrate() {
 					w.Entities = append(w.Entities, name)
+					for _, field := range w.lazyBytesFields(msg) {
+						w.Entities = append(w.Entities, w.lazyBytesName(msg, field))
+					}
 				}
 			}
 		}
 	}
-	for _, msg := range file.Messages() {
-		name := strings.Trim(w.generateModelName(msg.GetName()), " ")
-		w.Fields[name] = msg.GetField()
+	for _, msg := range fileMessages(file) {
+		name := strings.Trim(w.modelName(msg), " ")
+		w.Fields[name] = msg.Fields
 		if val, ok := w.PrivateEntities[name]; ok {
-			val.items = msg.GetField()
+			val.items = msg.Fields
 			w.PrivateEntities[name] = val
 		}
 	}
 
-	for _, msg := range file.Messages() {
+	for _, msg := range fileMessages(file) {
 		if wormMessage, ok := w.getMessageOptions(msg); ok {
 			if wormMessage.GetModel() {
 				w.generateUpdateMethod(msg, wormMessage.GetMerge())
+				w.generateUpdateWithMaskMethod(msg, wormMessage.GetMerge())
 			}
 		}
 	}
 
+	// collect tables of the versioned migrations
+	w.setSchemaTables(file)
 	// generate merge and covert methods
 	w.generateEntitiesMethods()
 	// generate connection methods
 	w.generateConnectionMethods()
+	// generate auto server implementations
+	w.generateServers(file)
+
+	w.writeGoFile(file)
 }
 
-func (w *WormPlugin) setJsonBFields(file *generator.FileDescriptor) {
-	for _, msg := range file.Messages() {
-		name := w.generateModelName(msg.GetName())
-		for _, fld := range msg.GetField() {
-			fieldName := fld.GetName()
-			fieldName = generator.CamelCase(fieldName)
+// resetImports - import flags are collected per file, the generator runs Generate for the dependencies as well
+func (w *WormPlugin) resetImports() {
+	w.useTime = false
+	w.useTimestamp = false
+	w.useDuration = false
+	w.useJsonb = false
+	w.useJson = false
+	w.useServer = false
+	w.useGrpc = false
+	w.useCtx = false
+	w.useWorm = false
+	w.useURL = false
+	w.useGormConfig = false
+	w.useFieldMask = false
+	w.useWrappers = false
+	w.useStrings = false
+	w.useProtoJSON = false
+	w.useStruct = false
+	w.useAny = false
+	w.useDriver = false
+	w.useCompress = false
+	w.useGormSchema = false
+}
+
+func (w *WormPlugin) setJsonBFields(file *protogen.File) {
+	for _, msg := range fileMessages(file) {
+		name := w.modelName(msg)
+		for _, fld := range msg.Fields {
+			fieldName := fld.GoName
 
 			objectFld := w.getFieldOptions(fld)
 			if objectFld != nil && objectFld.Tag != nil {
 				if objectFld.Tag.GetJsonb() {
 					w.JsonBFields[name+fieldName] = JsonBField{
 						name: fieldName,
-						tps:  fld.Type.String(),
+						kind: fld.Desc.Kind(),
 						fld:  fld,
 					}
 				}
@@ -216,44 +504,41 @@ func (w *WormPlugin) setJsonBFields(file *generator.FileDescriptor) {
 	}
 }
 
-func (w *WormPlugin) getFieldOptions(field *descriptor.FieldDescriptorProto) *worm.WormFieldOptions {
-	if field.Options == nil {
-		return nil
-	}
-	v, err := proto.GetExtension(field.Options, worm.E_Field)
-	if err != nil {
-		return nil
-	}
-	opts, ok := v.(*worm.WormFieldOptions)
-	if !ok {
-		return nil
-	}
+func (w *WormPlugin) getFieldOptions(field *protogen.Field) *worm.WormFieldOptions {
+	opts, _ := proto.GetExtension(field.Desc.Options(), worm.E_Field).(*worm.WormFieldOptions)
 	return opts
 }
 
-func (w *WormPlugin) generateValidationMethods(message *generator.Descriptor) {
+func (w *WormPlugin) generateValidationMethods(message *protogen.Message) {
 	w.P(`// isValid - validation method of the described protobuf structure `)
-	name := w.generateModelName(message.GetName())
+	name := w.modelName(message)
 	w.P(`func (e *`, name, `) IsValid() error {`)
 	w.P(`if _, err := valid.ValidateStruct(e); err != nil {`)
 	w.P(`return err`)
 	w.P(`}`)
+	if len(w.oneofGroups(message)) > 0 {
+		w.P(`if err := e.checkOneOfs(); err != nil {`)
+		w.P(`return err`)
+		w.P(`}`)
+	}
 	w.P(`return nil`)
 	w.P(`}`)
-	w.Out()
 	w.P(``)
 }
 
-func (w *WormPlugin) generateUpdateMethod(message *generator.Descriptor, privateName string) {
-	name := w.generateModelName(message.GetName())
+func (w *WormPlugin) generateUpdateMethod(message *protogen.Message, privateName string) {
+	name := w.modelName(message)
 
-	w.P(`// Update - update model method, a check is made on existing fields.`)
-	w.P(`func (e *`, name, `) UpdateIfExist(updateAt bool) (*`, name, `, error) {`)
+	w.useCtx = true
+	w.P(`// Update - update model method, a check is made on existing fields, the update runs in the transaction of the context.`)
+	w.P(`func (e *`, name, `) UpdateIfExist(ctx context.Context, updateAt bool) (*`, name, `, error) {`)
 	w.P(`updateEntities := make(map[string]interface{})`)
-	w.P(`query := e.G()`)
+	w.P(`// conditions are kept on a copy, the model gorm object is reused by the other methods`)
+	w.P(`query := e.dbContext(ctx).Session(&gorm.Session{WithConditions: true})`)
 	w.P()
 
-	fields := message.GetField()
+	fields := message.Fields
+	pk := w.primaryKeyField(message)
 
 	if len(w.PrivateEntities) > 0 && len(privateName) > 0 {
 		if val, ok := w.PrivateEntities[w.generateModelName(privateName)]; ok {
@@ -265,39 +550,80 @@ func (w *WormPlugin) generateUpdateMethod(message *generator.Descriptor, private
 
 	for _, field := range fields {
 		var isJsonb bool
-		fieldName := field.GetName()
+		fieldName := string(field.Desc.Name())
 
 		wgromField := w.getFieldOptions(field)
 		if wgromField != nil && wgromField.Tag != nil {
 			isJsonb = wgromField.Tag.GetJsonb()
 		}
 
-		if strings.ToLower(fieldName) == "id" {
-			w.P(`// check if fill id field`)
-			w.P(`if len(e.Id) > 0 {`)
-			w.P(`query = query.Where("id = ?", e.Id)`)
+		if field == pk {
+			w.P(`// check if fill primary key field`)
+			w.P(`if `, w.primaryKeySet(pk), ` {`)
+			w.P(`query = query.Where("`, w.columnName(pk), ` = ?", e.`, pk.GoName, `)`)
 			w.P(`}`)
+			continue
 		}
 
-		// skip _id field UpdatedAt
-		if strings.ToLower(fieldName) == "id" || strings.ToLower(fieldName) == "createdat" || strings.ToLower(fieldName) == "updatedat" {
+		// skip CreatedAt UpdatedAt
+		if strings.ToLower(fieldName) == "createdat" || strings.ToLower(fieldName) == "updatedat" {
 			continue
 		}
 
 		// find goType
-		goTyp, _ := w.GoType(message, field)
-		fieldName = generator.CamelCase(fieldName)
+		goTyp := w.goType(field)
+		fieldName = field.GoName
 		snakeName := snaker.CamelToSnake(fieldName)
-		oneOf := field.OneofIndex != nil
+		oneOf := w.isOneOf(field)
 
-		if oneOf {
+		if !w.isColumnField(field) && !w.isLazyBytes(field) {
+			continue
+		}
 
-			w.P(`// set `, fieldName)
+		if w.isLazyBytes(field) {
+
+			w.P(`// set `, fieldName, `, stored in a separate table`)
+			w.P(`if len(e.`, fieldName, `) > 0 {`)
+			w.P(`if err := e.save`, fieldName, `(ctx); err != nil {`)
+			w.P(`return e, err`)
+			w.P(`}`)
+			w.P(`}`)
+
+		} else if oneOf {
+
+			if !w.isColumnField(field) {
+				continue
+			}
+			w.P(`// set `, fieldName, `, other members of the oneof are cleared`)
+			w.P(`if `, w.oneofSet(field), ` {`)
+			w.P(`updateEntities["`, snakeName, `"]  = e.`, fieldName)
+			for _, sibling := range w.oneofSiblings(field) {
+				w.P(`updateEntities["`, sibling, `"]  = nil`)
+			}
+			w.P(`}`)
+
+		} else if w.isOptional(field) {
+
+			w.P(`// set `, fieldName, ` when presence is set`)
 			w.P(`if e.`, fieldName, ` != nil {`)
-			w.P(`updateEntities["`, snakeName, `"]  = e.Get`, fieldName, `()`)
+			w.P(`updateEntities["`, snakeName, `"]  = e.`, fieldName)
+			w.P(`}`)
+
+		} else if isBytes(field) && !isRepeated(field) {
+
+			w.P(`// set `, fieldName, `, empty value is set only by the update mask`)
+			w.P(`if len(e.`, fieldName, `) > 0 {`)
+			w.P(`updateEntities["`, snakeName, `"]  = e.`, fieldName)
+			w.P(`}`)
+
+		} else if isEnum(field) && !isRepeated(field) {
+
+			w.P(`// set `, fieldName, `, zero value is set only by the update mask`)
+			w.P(`if e.`, fieldName, ` != 0 {`)
+			w.P(`updateEntities["`, snakeName, `"]  = e.`, fieldName)
 			w.P(`}`)
 
-		} else if field.IsScalar() {
+		} else if isScalar(field) {
 
 			if strings.ToLower(goTyp) == "bool" {
 				w.P(`// set `, fieldName)
@@ -321,16 +647,22 @@ func (w *WormPlugin) generateUpdateMethod(message *generator.Descriptor, private
 			w.P(`}`)
 			w.P(`}`)
 
-		} else if strings.EqualFold(goTyp, "*timestamppb.Timestamp") {
-			goTyp = "time.Time"
+		} else if cond, ok := w.wellKnownUpdateCondition(field, fieldName); ok {
+
+			w.P(`// set `, fieldName)
+			w.P(`if `, cond, ` {`)
+			w.P(`updateEntities["`, snakeName, `"]  = e.`, fieldName)
+			w.P(`}`)
+
+		} else if typeName(field) == timestampType {
 			w.useTime = true
 
 			w.P(`// set `, fieldName)
-			w.P(`if !e.`, fieldName, `.IsZero() {`)
+			w.P(`if e.`, fieldName, ` != nil {`)
 			w.P(`updateEntities["`, snakeName, `"]  = e.`, fieldName)
 			w.P(`}`)
 
-		} else if w.IsMap(field) {
+		} else if isMap(field) {
 
 			w.P(`// set `, fieldName)
 			w.P(`if len(e.`, fieldName, `) > 0 {`)
@@ -339,7 +671,7 @@ func (w *WormPlugin) generateUpdateMethod(message *generator.Descriptor, private
 
 		} else {
 
-			if field.IsMessage() {
+			if isMessage(field) {
 
 				w.P(`// set `, fieldName)
 				w.P(`if e.`, fieldName, ` != nil {`)
@@ -348,23 +680,17 @@ func (w *WormPlugin) generateUpdateMethod(message *generator.Descriptor, private
 
 			} else {
 
-				if field.IsEnum() {
-					w.P(`// set `, fieldName)
-					w.P(`if len(e.`, fieldName, `.String()) > 0 {`)
-					w.P(`updateEntities["`, snakeName, `"]  = e.`, fieldName)
-					w.P(`}`)
-				} else {
-					w.P(`// set `, fieldName)
-					w.P(`if len(e.`, fieldName, `) > 0 {`)
-					w.P(`updateEntities["`, snakeName, `"]  = e.`, fieldName)
-					w.P(`}`)
-				}
+				w.P(`// set `, fieldName)
+				w.P(`if len(e.`, fieldName, `) > 0 {`)
+				w.P(`updateEntities["`, snakeName, `"]  = e.`, fieldName)
+				w.P(`}`)
 			}
 
 		}
 
 	}
 
+	w.useTime = true
 	w.P(` if updateAt {`)
 	w.P(`updateEntities["updated_at"] = time.Now()`)
 	w.P(` }`)
@@ -373,157 +699,55 @@ func (w *WormPlugin) generateUpdateMethod(message *generator.Descriptor, private
 	w.P(` return e, err`)
 	w.P(` }`)
 
+	w.P(`if err := e.InvalidateCache(); err != nil {`)
+	w.P(`return e, err`)
+	w.P(`}`)
 	w.P(` return e, nil`)
 	w.P(`}`)
 	w.P()
 }
 
-func (w *WormPlugin) goMapTypeCustomPB(d *generator.Descriptor, field *descriptor.FieldDescriptorProto) (*generator.GoMapDescriptor, bool) {
-	var isMessage = false
-	if d == nil {
-		byName := w.ObjectNamed(field.GetTypeName())
-		desc, ok := byName.(*generator.Descriptor)
-		if byName == nil || !ok || !desc.GetOptions().GetMapEntry() {
-			w.Fail(fmt.Sprintf("field %s is not a map", field.GetTypeName()))
-			return nil, false
-		}
-		d = desc
-	}
-
-	m := &generator.GoMapDescriptor{
-		KeyField:   d.Field[0],
-		ValueField: d.Field[1],
-	}
-
-	// Figure out the Go types and tags for the key and value types.
-	m.KeyAliasField, m.ValueAliasField = w.GetMapKeyField(field, m.KeyField), w.GetMapValueField(field, m.ValueField)
-	keyType, _ := w.GoType(d, m.KeyAliasField)
-	valType, _ := w.GoType(d, m.ValueAliasField)
-
-	// We don't use stars, except for message-typed values.
-	// Message and enum types are the only two possibly foreign types used in maps,
-	// so record their use. They are not permitted as map keys.
-	keyType = strings.TrimPrefix(keyType, "*")
-	switch *m.ValueAliasField.Type {
-	case descriptor.FieldDescriptorProto_TYPE_ENUM:
-		valType = strings.TrimPrefix(valType, "*")
-		w.RecordTypeUse(m.ValueAliasField.GetTypeName())
-	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
-		if !gogoproto.IsNullable(m.ValueAliasField) {
-			valType = strings.TrimPrefix(valType, "*")
-		}
-		if !gogoproto.IsStdType(m.ValueAliasField) && !gogoproto.IsCustomType(field) && !gogoproto.IsCastType(field) {
-			isMessage = true
-			w.RecordTypeUse(m.ValueAliasField.GetTypeName())
-		}
-	default:
-		if gogoproto.IsCustomType(m.ValueAliasField) {
-			if !gogoproto.IsNullable(m.ValueAliasField) {
-
-				valType = strings.TrimPrefix(valType, "*")
-			}
-			if !gogoproto.IsStdType(field) {
-				w.RecordTypeUse(m.ValueAliasField.GetTypeName())
-			}
-		} else {
-
-			valType = strings.TrimPrefix(valType, "*")
-		}
-	}
-
-	m.GoType = fmt.Sprintf("map[%s]%s", keyType, valType)
-	return m, isMessage
+// goMapTypeCustomPB - go type of the map field in the protobuf message, true when the values are messages
+func (w *WormPlugin) goMapTypeCustomPB(field *protogen.Field) (string, bool) {
+	key, value := field.Message.Fields[0], field.Message.Fields[1]
+	return "map[" + w.goElemType(key) + "]" + w.goElemType(value), isMessage(value)
 }
 
-func (w *WormPlugin) goMapTypeCustomGorm(d *generator.Descriptor, field *descriptor.FieldDescriptorProto) (*generator.GoMapDescriptor, bool) {
-	var isMessage = false
-	if d == nil {
-		byName := w.ObjectNamed(field.GetTypeName())
-		desc, ok := byName.(*generator.Descriptor)
-		if byName == nil || !ok || !desc.GetOptions().GetMapEntry() {
-			w.Fail(fmt.Sprintf("field %s is not a map", field.GetTypeName()))
-			return nil, false
-		}
-		d = desc
-	}
-
-	m := &generator.GoMapDescriptor{
-		KeyField:   d.Field[0],
-		ValueField: d.Field[1],
-	}
-
-	// Figure out the Go types and tags for the key and value types.
-	m.KeyAliasField, m.ValueAliasField = w.GetMapKeyField(field, m.KeyField), w.GetMapValueField(field, m.ValueField)
-	keyType, _ := w.GoType(d, m.KeyAliasField)
-	valType, _ := w.GoType(d, m.ValueAliasField)
-
-	// We don't use stars, except for message-typed values.
-	// Message and enum types are the only two possibly foreign types used in maps,
-	// so record their use. They are not permitted as map keys.
-	keyType = strings.TrimPrefix(keyType, "*")
-	switch *m.ValueAliasField.Type {
-	case descriptor.FieldDescriptorProto_TYPE_ENUM:
-		valType = strings.TrimPrefix(valType, "*")
-		w.RecordTypeUse(m.ValueAliasField.GetTypeName())
-	case descriptor.FieldDescriptorProto_TYPE_MESSAGE:
-		if !gogoproto.IsNullable(m.ValueAliasField) {
-			valType = strings.TrimPrefix(valType, "*")
-		}
-		if !gogoproto.IsStdType(m.ValueAliasField) && !gogoproto.IsCustomType(field) && !gogoproto.IsCastType(field) {
-			valType = w.generateModelName(valType)
-			isMessage = true
-			w.RecordTypeUse(m.ValueAliasField.GetTypeName())
-		}
-	default:
-		if gogoproto.IsCustomType(m.ValueAliasField) {
-			if !gogoproto.IsNullable(m.ValueAliasField) {
-
-				valType = strings.TrimPrefix(valType, "*")
-			}
-			if !gogoproto.IsStdType(field) {
-				w.RecordTypeUse(m.ValueAliasField.GetTypeName())
-			}
-		} else {
-
-			valType = strings.TrimPrefix(valType, "*")
-		}
+// goMapTypeCustomGorm - go type of the map field in the model, message values are models
+func (w *WormPlugin) goMapTypeCustomGorm(field *protogen.Field) (string, bool) {
+	key, value := field.Message.Fields[0], field.Message.Fields[1]
+	valType := w.goElemType(value)
+	if isMessage(value) {
+		w.checkWormReference(field.Message, value)
+		valType = w.generateModelName(valType)
 	}
-
-	m.GoType = fmt.Sprintf("map[%s]%s", keyType, valType)
-	return m, isMessage
+	return "map[" + w.goElemType(key) + "]" + valType, isMessage(value)
 }
 
-func (w *WormPlugin) getMessageOptions(message *generator.Descriptor) (*worm.WormMessageOptions, bool) {
-	opt := message.GetOptions()
-	if opt != nil {
-		v, err := proto.GetExtension(opt, worm.E_Opts)
-		if err != nil {
-			return nil, false
-		}
-		wormMessage, ok := v.(*worm.WormMessageOptions)
-		if !ok {
-			return nil, false
-		}
-		return wormMessage, true
-	}
-	return nil, false
+func (w *WormPlugin) getMessageOptions(message *protogen.Message) (*worm.WormMessageOptions, bool) {
+	opts, ok := proto.GetExtension(message.Desc.Options(), worm.E_Opts).(*worm.WormMessageOptions)
+	return opts, ok && opts != nil
 }
 
-func (w *WormPlugin) GetServiceName(file *generator.FileDescriptor) string {
+func (w *WormPlugin) GetServiceName(file *protogen.File) string {
 	var name string
-	for _, svc := range file.Service {
-		if svc != nil && svc.Name != nil {
-			return *svc.Name
-		}
+	if len(file.Services) > 0 {
+		return string(file.Services[0].Desc.Name())
 	}
-	name = *file.Name
+	// files without services are named by the base name, dirs of the proto path are not a part of the identifiers
+	name = path.Base(file.Desc.Path())
 	if ext := path.Ext(name); ext == ".proto" || ext == ".protodevel" {
 		name = name[:len(name)-len(ext)]
 	}
-	return name
+	return strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
 }
 func (w *WormPlugin) generateGlobalVariables() {
-	w.P(`// global gorm variable`)
+	w.P(`// global gorm variable, set only in the compatibility mode (`, w.nameWithServicePrefix("WithGlobalDB"), ` option)`)
 	dataStoreStructure := w.nameWithServicePrefix("DB")
 	w.P(`var `, dataStoreStructure, ` *gorm.DB`)
 }
@@ -534,41 +758,99 @@ func (w *WormPlugin) generateConnectionMethods() {
 	// create dataStore
 	w.CreateDataStoreStructure(dataStoreStructure)
 
-	ssl := "disable"
-	if w.SSLMode {
-		ssl = "require"
-	}
-
 	w.P()
 	w.P(`// `, functionName, ` - db connection`)
-	w.P(`func (d *`, dataStoreStructure, `) `, functionName, `(host, port, name, user, password string) (*gorm.DB, error) {`)
+	w.P(`func (d *`, dataStoreStructure, `) `, functionName, `(cfg `, w.dataStoreConfigName(), `) (*gorm.DB, error) {`)
 	w.P(`var ssl string`)
-	w.P(`ssl = "`, ssl, `"`)
-	w.P(`if len(os.Getenv("DB_SSL_MODE")) > 0 {`)
-	w.P(`ssl = os.Getenv("DB_SSL_MODE")`)
+	w.P(`ssl = "`, w.tlsMode(w.SSLMode), `"`)
+	w.P(`if len(cfg.SSLMode) > 0 {`)
+	w.P(`ssl = cfg.SSLMode`)
+	w.P(`}`)
+	w.P()
+	w.P(`connectionString := cfg.DSN`)
+	w.P(`if len(connectionString) == 0 {`)
+	w.P(`connectionString = d.dsn(cfg.Host, cfg.Port, cfg.Name, cfg.User, cfg.Password, ssl)`)
+	w.P(`}`)
+	w.P(`gormConfig := cfg.Gorm`)
+	w.P(`if gormConfig == nil {`)
+	w.P(`gormConfig = &gorm.Config{}`)
 	w.P(`}`)
-
-	w.P(`connectionString := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=" + ssl,`)
-	w.P(`host,`)
-	w.P(`port,`)
-	w.P(`user,`)
-	w.P(`password,`)
-	w.P(`name)`)
 	switch w.GetDBDriver() {
 	case "postgres":
-		w.P(`db, err := gorm.Open(postgres.Open(connectionString), &gorm.Config{})`)
+		w.P(`db, err := gorm.Open(postgres.Open(connectionString), gormConfig)`)
 	case "mysql":
-		w.P(`db, err := gorm.Open(mysql.Open(connectionString), &gorm.Config{})`)
-	case "mssql":
-		w.P(`db, err := gorm.Open(mssql.Open(connectionString), &gorm.Config{})`)
+		w.P(`db, err := gorm.Open(mysql.Open(connectionString), gormConfig)`)
+	case "mssql", "sqlserver":
+		w.P(`db, err := gorm.Open(sqlserver.Open(connectionString), gormConfig)`)
 	case "sqlite":
-		w.P(`db, err := gorm.Open(sqlite.Open(connectionString), &gorm.Config{})`)
+		w.P(`db, err := gorm.Open(sqlite.Open(connectionString), gormConfig)`)
 	}
 	w.P(`if err != nil {`)
 	w.P(`return nil, err`)
 	w.P(`}`)
 	w.P(`return db, nil`)
 	w.P(`}`)
+
+	w.generateDSNMethod(dataStoreStructure)
+}
+
+// tlsMode - driver specific value of the tls setting
+func (w *WormPlugin) tlsMode(enabled bool) string {
+	switch w.GetDBDriver() {
+	case "mysql":
+		if enabled {
+			return "true"
+		}
+		return "false"
+	case "mssql", "sqlserver":
+		if enabled {
+			return "true"
+		}
+		return "disable"
+	case "sqlite":
+		return ""
+	}
+	if enabled {
+		return "require"
+	}
+	return "disable"
+}
+
+// generateDSNMethod - driver specific connection string builder
+func (w *WormPlugin) generateDSNMethod(dataStoreStructure string) {
+	w.P()
+	w.P(`// dsn - `, w.GetDBDriver(), ` connection string, ssl is the driver specific tls setting`)
+	w.P(`func (d *`, dataStoreStructure, `) dsn(host, port, name, user, password, ssl string) string {`)
+	switch w.GetDBDriver() {
+	case "mysql":
+		w.P(`return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?parseTime=true&tls=%s", user, password, host, port, name, ssl)`)
+	case "mssql", "sqlserver":
+		w.useURL = true
+		w.P(`query := url.Values{}`)
+		w.P(`query.Set("database", name)`)
+		w.P(`query.Set("encrypt", ssl)`)
+		w.P(`dsn := url.URL{`)
+		w.P(`Scheme:   "sqlserver",`)
+		w.P(`User:     url.UserPassword(user, password),`)
+		w.P(`Host:     host + ":" + port,`)
+		w.P(`RawQuery: query.Encode(),`)
+		w.P(`}`)
+		w.P(`return dsn.String()`)
+	case "sqlite":
+		w.P(`// sqlite database is a file, name is the path to it`)
+		w.P(`return name`)
+	default:
+		w.P(`return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s", host, port, user, password, name, ssl)`)
+	}
+	w.P(`}`)
+}
+
+func (w *WormPlugin) dataStoreConfigName() string {
+	return w.nameWithServicePrefix("DataStoreConfig")
+}
+
+func (w *WormPlugin) dataStoreOptionName() string {
+	return w.nameWithServicePrefix("DataStoreOption")
 }
 
 func (w *WormPlugin) CreateDataStoreStructure(name string) {
@@ -580,40 +862,238 @@ func (w *WormPlugin) CreateDataStoreStructure(name string) {
 	w.P(`}`)
 	functionName := "New" + name
 
-	w.P(`// `, functionName, ` - dataStore constructor`)
-	w.P(`func `, functionName, `() (*`, name, `, error) {`)
+	w.generateDataStoreConfig()
+
+	w.P(`// `, functionName, ` - dataStore constructor, connection settings are read from the environment`)
+	w.P(`func `, functionName, `(opts ...`, w.dataStoreOptionName(), `) (*`, name, `, error) {`)
+	w.P(`return `, functionName, `WithConfig(`, w.dataStoreConfigName(), `FromEnv(), opts...)`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, functionName, `WithConfig - dataStore constructor`)
+	w.P(`func `, functionName, `WithConfig(cfg `, w.dataStoreConfigName(), `, opts ...`, w.dataStoreOptionName(), `) (*`, name, `, error) {`)
+	w.P(`for _, opt := range opts {`)
+	w.P(`opt(&cfg)`)
+	w.P(`}`)
 	w.P(`store := &`, name, `{}`)
-	w.P(`db, err := store.connection(os.Getenv("DB_HOST"), os.Getenv("DB_PORT"), os.Getenv("DB_NAME"), os.Getenv("DB_USER"), os.Getenv("DB_PASSWORD"))`)
+	w.P(`db := cfg.db`)
+	w.P(`if db == nil {`)
+	w.P(`conn, err := store.connection(cfg)`)
 	w.P(`if err != nil {`)
 	w.P(`return store, err`)
 	w.P(`}`)
-	w.P(`if db == nil {`)
-	w.P(`store.db = db`)
+	w.P(`db = conn`)
 	w.P(`}`)
-	w.P(``)
+	w.P(`if err := store.pool(db, cfg); err != nil {`)
+	w.P(`return store, err`)
+	w.P(`}`)
+	w.P(`store.db = db`)
 	w.P()
-	w.P(`if `, db, ` == nil {`)
+	w.P(`if cfg.global {`)
 	w.P(db, ` = db`)
 	w.P(`}`)
 	w.P()
-	w.P(`store.migrate()`)
+	w.P(`if cfg.AutoMigrate {`)
+	w.P(`if err := store.migrate(); err != nil {`)
 	w.P(`return store, err`)
 	w.P(`}`)
+	w.P(`}`)
+	w.P(`return store, nil`)
+	w.P(`}`)
+	w.P()
+
+	w.useTime = true
+	w.P(`// pool - connection pool settings`)
+	w.P(`func (d *`, name, `) pool(db *gorm.DB, cfg `, w.dataStoreConfigName(), `) error {`)
+	w.P(`if cfg.MaxOpenConns == 0 && cfg.MaxIdleConns == 0 && cfg.ConnMaxLifetime == 0 {`)
+	w.P(`return nil`)
+	w.P(`}`)
+	w.P(`sqlDB, err := db.DB()`)
+	w.P(`if err != nil {`)
+	w.P(`return err`)
+	w.P(`}`)
+	w.P(`if cfg.MaxOpenConns > 0 {`)
+	w.P(`sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)`)
+	w.P(`}`)
+	w.P(`if cfg.MaxIdleConns > 0 {`)
+	w.P(`sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)`)
+	w.P(`}`)
+	w.P(`if cfg.ConnMaxLifetime > 0 {`)
+	w.P(`sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)`)
+	w.P(`}`)
+	w.P(`return nil`)
+	w.P(`}`)
 	w.P()
 
+	w.P(`// DB - gorm connection of the data store`)
+	w.P(`func (d *`, name, `) DB() *gorm.DB {`)
+	w.P(`return d.db`)
+	w.P(`}`)
+	w.P()
+
+	for _, model := range w.Models {
+		mName := w.generateModelName(model)
+		w.P(`// `, model, ` - `, mName, ` bound to the data store connection`)
+		w.P(`func (d *`, name, `) `, model, `() *`, mName, ` {`)
+		w.P(`return New`, mName, `().SetGorm(d.db)`)
+		w.P(`}`)
+		w.P()
+	}
+
 	w.P(`// Migrate - gorm AutoMigrate`)
-	w.P(`func (d *`, name, `) migrate() {`)
+	w.P(`func (d *`, name, `) migrate() error {`)
+	for _, enum := range w.nativeEnums(w.currentFile) {
+		w.P(`// native enum type, gorm AutoMigrate does not create types`)
+		w.P("if err := d.db.Exec(`DO $$ BEGIN ", strings.TrimSuffix(w.createEnumStatement(enum), "\n"), " EXCEPTION WHEN duplicate_object THEN null; END $$;`).Error; err != nil {")
+		w.P(`return err`)
+		w.P(`}`)
+	}
 	if len(w.Entities) > 0 {
-		w.P(db, `.AutoMigrate(`)
+		w.P(`return d.db.AutoMigrate(`)
 		for _, enitity := range w.Entities {
 			w.P(`&`, enitity, `{},`)
 		}
 		w.P(`)`)
+	} else {
+		w.P(`return nil`)
 	}
 	w.P(`}`)
 }
 
-func (w *WormPlugin) setCovertEntities(message *generator.Descriptor, name string) {
+// generateDataStoreConfig - data store configuration and functional options
+func (w *WormPlugin) generateDataStoreConfig() {
+	config := w.dataStoreConfigName()
+	option := w.dataStoreOptionName()
+	w.useGormConfig = true
+
+	w.P()
+	w.P(`// `, config, ` - data store configuration, DSN wins over the connection fields`)
+	w.P(`type `, config, ` struct {`)
+	w.P(`DSN      string`)
+	w.P(`Host     string`)
+	w.P(`Port     string`)
+	w.P(`Name     string`)
+	w.P(`User     string`)
+	w.P(`Password string`)
+	w.P(`SSLMode  string`)
+	w.P()
+	w.P(`MaxOpenConns    int`)
+	w.P(`MaxIdleConns    int`)
+	w.P(`ConnMaxLifetime time.Duration`)
+	w.P()
+	w.P(`Gorm        *gorm.Config`)
+	w.P(`AutoMigrate bool`)
+	w.P()
+	w.P(`db     *gorm.DB`)
+	w.P(`global bool`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, config, `FromEnv - configuration read from DB_HOST, DB_PORT, DB_NAME, DB_USER, DB_PASSWORD and DB_SSL_MODE`)
+	w.P(`func `, config, `FromEnv() `, config, ` {`)
+	w.P(`return `, config, `{`)
+	w.P(`Host:        os.Getenv("DB_HOST"),`)
+	w.P(`Port:        os.Getenv("DB_PORT"),`)
+	w.P(`Name:        os.Getenv("DB_NAME"),`)
+	w.P(`User:        os.Getenv("DB_USER"),`)
+	w.P(`Password:    os.Getenv("DB_PASSWORD"),`)
+	w.P(`SSLMode:     os.Getenv("DB_SSL_MODE"),`)
+	w.P(`AutoMigrate: true,`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, option, ` - data store option`)
+	w.P(`type `, option, ` func(*`, config, `)`)
+	w.P()
+
+	prefix := w.nameWithServicePrefix("With")
+	w.P(`// `, prefix, `DSN - explicit connection string`)
+	w.P(`func `, prefix, `DSN(dsn string) `, option, ` {`)
+	w.P(`return func(cfg *`, config, `) {`)
+	w.P(`cfg.DSN = dsn`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, prefix, `DB - use existing gorm connection instead of opening a new one`)
+	w.P(`func `, prefix, `DB(db *gorm.DB) `, option, ` {`)
+	w.P(`return func(cfg *`, config, `) {`)
+	w.P(`cfg.db = db`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, prefix, `Pool - connection pool sizes and connection lifetime`)
+	w.P(`func `, prefix, `Pool(maxOpen, maxIdle int, lifetime time.Duration) `, option, ` {`)
+	w.P(`return func(cfg *`, config, `) {`)
+	w.P(`cfg.MaxOpenConns = maxOpen`)
+	w.P(`cfg.MaxIdleConns = maxIdle`)
+	w.P(`cfg.ConnMaxLifetime = lifetime`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, prefix, `GormConfig - gorm configuration`)
+	w.P(`func `, prefix, `GormConfig(gormConfig *gorm.Config) `, option, ` {`)
+	w.P(`return func(cfg *`, config, `) {`)
+	w.P(`cfg.Gorm = gormConfig`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, prefix, `Logger - gorm logger`)
+	w.P(`func `, prefix, `Logger(l logger.Interface) `, option, ` {`)
+	w.P(`return func(cfg *`, config, `) {`)
+	w.P(`if cfg.Gorm == nil {`)
+	w.P(`cfg.Gorm = &gorm.Config{}`)
+	w.P(`}`)
+	w.P(`cfg.Gorm.Logger = l`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, prefix, `NamingStrategy - gorm naming strategy of tables and columns`)
+	w.P(`func `, prefix, `NamingStrategy(namer schema.Namer) `, option, ` {`)
+	w.P(`return func(cfg *`, config, `) {`)
+	w.P(`if cfg.Gorm == nil {`)
+	w.P(`cfg.Gorm = &gorm.Config{}`)
+	w.P(`}`)
+	w.P(`cfg.Gorm.NamingStrategy = namer`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, prefix, `PrepareStmt - cache prepared statements`)
+	w.P(`func `, prefix, `PrepareStmt(prepare bool) `, option, ` {`)
+	w.P(`return func(cfg *`, config, `) {`)
+	w.P(`if cfg.Gorm == nil {`)
+	w.P(`cfg.Gorm = &gorm.Config{}`)
+	w.P(`}`)
+	w.P(`cfg.Gorm.PrepareStmt = prepare`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, prefix, `GlobalDB - compatibility mode, store the connection in the global `, w.nameWithServicePrefix("DB"))
+	w.P(`// used by the models which are not bound to a data store`)
+	w.P(`func `, prefix, `GlobalDB() `, option, ` {`)
+	w.P(`return func(cfg *`, config, `) {`)
+	w.P(`cfg.global = true`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+
+	w.P(`// `, prefix, `AutoMigrate - toggle gorm AutoMigrate of the models on start`)
+	w.P(`func `, prefix, `AutoMigrate(migrate bool) `, option, ` {`)
+	w.P(`return func(cfg *`, config, `) {`)
+	w.P(`cfg.AutoMigrate = migrate`)
+	w.P(`}`)
+	w.P(`}`)
+	w.P()
+}
+
+func (w *WormPlugin) setCovertEntities(message *protogen.Message, name string) {
 	opt, ok := w.getMessageOptions(message)
 	if ok {
 		if entity := opt.GetConvertTo(); len(entity) > 0 {
@@ -632,7 +1112,7 @@ func (w *WormPlugin) setCovertEntities(message *generator.Descriptor, name strin
 	}
 }
 
-func (w *WormPlugin) generateModelStructures(message *generator.Descriptor, name string) {
+func (w *WormPlugin) generateModelStructures(message *protogen.Message, name string) {
 	w.P(`// create gorm model from protobuf (`, name, `)`)
 	w.P(`type `, name, ` struct {`)
 
@@ -649,37 +1129,24 @@ func (w *WormPlugin) generateModelStructures(message *generator.Descriptor, name
 		}
 	}
 
-	type useUnsafeMethod struct {
-		fieldName string
-		goTyp     string
-		mName     string
-	}
-
-	var nsafeScope []useUnsafeMethod
-	for _, field := range message.GetField() {
-		fieldName := field.GetName()
-		oneOf := field.OneofIndex != nil
-		goTyp, _ := w.GoType(message, field)
+	for _, field := range message.Fields {
+		fieldName := field.GoName
+		oneOf := w.isOneOf(field)
+		goTyp := w.goType(field)
 		var isJsonb bool
 
-		if oneOf && strings.EqualFold(goTyp, "*timestamppb.Timestamp") {
-			goTyp = "time.Time"
-		}
-		if oneOf {
-			w.useUnsafe = true
-			nsafeScope = append(nsafeScope, useUnsafeMethod{
-				fieldName: strings.Title(fieldName),
-				goTyp:     goTyp,
-				mName:     name,
-			})
-		}
-
-		fieldName = generator.CamelCase(fieldName)
 		wgromField := w.getFieldOptions(field)
 		var tagString string
+		implied := w.impliedGormTag(field)
+		if len(implied) > 0 && (wgromField == nil || wgromField.Tag == nil) {
+			tagString = "`gorm:\"" + implied + "\"`"
+		}
 		if wgromField != nil && wgromField.Tag != nil {
 			gormTag := wgromField.Tag.GetGorm()
 			isJsonb = wgromField.Tag.GetJsonb()
+			if len(implied) > 0 {
+				gormTag = strings.TrimSuffix(implied+";"+gormTag, ";")
+			}
 
 			tagString = "`"
 			if len(gormTag) > 0 {
@@ -699,23 +1166,26 @@ func (w *WormPlugin) generateModelStructures(message *generator.Descriptor, name
 		}
 
 		if oneOf {
-
-			if strings.EqualFold(goTyp, "*timestamppb.Timestamp") {
+			w.P(fieldName, ` `, w.oneofGoType(message, field), tagString)
+		} else if w.isOptional(field) {
+			w.P(fieldName, ` `, goTyp, tagString)
+		} else if enum, ok := w.storedEnum(field); ok {
+			w.P(fieldName, ` `, w.enumModelName(enum), tagString)
+		} else if compressed, ok := w.bytesGoType(field); ok {
+			w.P(fieldName, ` `, compressed, tagString)
+		} else if isMap(field) {
+			mapType, _ := w.goMapTypeCustomGorm(field)
+			w.P(fieldName, ` `, mapType, tagString)
+		} else if wrapper, ok := w.wrapperType(field); ok {
+			w.P(fieldName, ` `, wrapper.goType, tagString)
+		} else if wellKnown, ok := w.wellKnownGoType(field); ok {
+			w.P(fieldName, ` `, wellKnown, tagString)
+		} else if isMessage(field) {
+			if typeName(field) == timestampType {
 				w.P(fieldName, ` *time.Time`, tagString)
 				w.useTime = true
 			} else {
-				w.P(fieldName, ` *`, goTyp, tagString)
-			}
-
-		} else if w.IsMap(field) {
-			m, _ := w.goMapTypeCustomGorm(nil, field)
-			w.P(fieldName, ` `, m.GoType, tagString)
-		} else if (field.IsMessage() && !gogoproto.IsCustomType(field) && !gogoproto.IsStdType(field)) || w.IsGroup(field) {
-			if strings.EqualFold(goTyp, "*timestamppb.Timestamp") {
-				w.P(fieldName, ` time.Time`, tagString)
-				w.useTime = true
-			} else {
-				w.P(fieldName, ` `, w.generateModelName(goTyp), tagString)
+				w.P(fieldName, ` `, w.messageModelType(message, field), tagString)
 			}
 		} else if isJsonb {
 			w.useJsonb = true
@@ -742,102 +1212,83 @@ func (w *WormPlugin) generateModelStructures(message *generator.Descriptor, name
 	}
 	w.P(`}`)
 
-	for _, s := range nsafeScope {
-		w.P(``)
-		w.P(`//Check method `, s.fieldName, ` - update field`)
-		w.P(`func (e *`, name, `) Get`, s.fieldName, `() `, s.goTyp, ` {`)
-		w.P(`var resp `, s.goTyp)
-		w.P(`if e.`, s.fieldName, ` != nil {`)
-		w.P(`resp = *((*`, s.goTyp, `)(unsafe.Pointer(e.`, s.fieldName, `)))`)
-		w.P(`}`)
-		w.P(`return resp`)
-		w.P(`}`)
-		w.P(``)
-	}
+	w.generateOneOfGetters(message)
+	w.generateOneOfCheck(message)
 }
 
-func (w *WormPlugin) toPB(message *generator.Descriptor) {
-	w.In()
-	mName := w.generateModelName(message.GetName())
-	w.P(`func (e *`, mName, `) ToPB() *`, message.GetName(), ` {`)
-	w.P(`var resp `, message.GetName())
-	for _, field := range message.GetField() {
+func (w *WormPlugin) toPB(message *protogen.Message) {
+	mName := w.modelName(message)
+	w.P(`func (e *`, mName, `) ToPB() *`, w.messageName(message), ` {`)
+	w.P(`var resp `, w.messageName(message))
+	oneofs := make(map[*protogen.Oneof]bool)
+	for _, field := range message.Fields {
+		if w.isOneOf(field) {
+			if !oneofs[field.Oneof] {
+				oneofs[field.Oneof] = true
+				w.oneofToPB(field.Oneof)
+			}
+			continue
+		}
 		bomField := w.getFieldOptions(field)
 		w.ToPBFields(field, message, bomField)
 	}
 	w.P(`return &resp`)
 	w.P(`}`)
-	w.Out()
 	w.P(``)
 }
 
-func (w *WormPlugin) toGorm(message *generator.Descriptor) {
-	w.In()
-	mName := w.generateModelName(message.GetName())
-	w.P(`func (e *`, message.GetName(), `) ToGorm() *`, mName, ` {`)
+func (w *WormPlugin) toGorm(message *protogen.Message) {
+	mName := w.modelName(message)
+	w.P(`func (e *`, w.messageName(message), `) ToGorm() *`, mName, ` {`)
 	w.P(`var resp `, mName)
-	for _, field := range message.GetField() {
+	for _, field := range message.Fields {
+		if w.isOneOf(field) {
+			w.oneofToGorm(field)
+			continue
+		}
 		bomwgromFieldsield := w.getFieldOptions(field)
 		w.ToGormFields(field, message, bomwgromFieldsield)
 	}
 	w.P(`return &resp`)
 	w.P(`}`)
-	w.Out()
 	w.P(``)
 }
 
-func (w *WormPlugin) ToGormFields(field *descriptor.FieldDescriptorProto, message *generator.Descriptor, bomField *worm.WormFieldOptions) {
+func (w *WormPlugin) ToGormFields(field *protogen.Field, message *protogen.Message, bomField *worm.WormFieldOptions) {
 
-	name := w.generateModelName(message.GetName())
-	fieldName := field.GetName()
-	fieldName = generator.CamelCase(fieldName)
-	goTyp, _ := w.GoType(message, field)
-	oneof := field.OneofIndex != nil
+	name := w.modelName(message)
+	fieldName := field.GoName
+	goTyp := w.goType(field)
 
 	var jField *JsonBField
 	if val, ok := w.JsonBFields[name+fieldName]; ok {
 		jField = &val
 	}
 
-	w.In()
-	if w.IsMap(field) {
-		m, ism := w.goMapTypeCustomGorm(nil, field)
-		_, keyField, keyAliasField := m.GoType, m.KeyField, m.KeyAliasField
-		keygoTyp, _ := w.GoType(nil, keyField)
-		keygoTyp = strings.Replace(keygoTyp, "*", "", 1)
-		keygoAliasTyp, _ := w.GoType(nil, keyAliasField)
-		keygoAliasTyp = strings.Replace(keygoAliasTyp, "*", "", 1)
-		w.P(`tt`, fieldName, ` := make(`, m.GoType, `)`)
+	if isMap(field) {
+		mapType, ism := w.goMapTypeCustomGorm(field)
+		w.P(`tt`, fieldName, ` := make(`, mapType, `)`)
 		w.P(`for k, v := range e.`, fieldName, ` {`)
-		w.In()
 		if ism {
 			w.P(`tt`, fieldName, `[k] = v.ToGorm()`)
 		} else {
 			w.P(`tt`, fieldName, `[k] = v`)
 		}
-		w.Out()
 		w.P(`}`)
 		w.P(`resp.`, fieldName, ` = tt`, fieldName)
-	} else if (field.IsMessage() && !gogoproto.IsCustomType(field) && !gogoproto.IsStdType(field)) || w.IsGroup(field) {
-
-		if strings.EqualFold(goTyp, "*timestamppb.Timestamp") && oneof {
+	} else if isMessage(field) {
 
-			sourceName := w.GetFieldName(message, field)
-			w.P(`// time oneof`)
-			w.P(`if e.Get`, sourceName, `() != nil {`)
-			w.P(`// create time object`)
-			w.P(`ut`, fieldName, ` := time.Unix(e.Get`, fieldName, `().GetSeconds(), int64(e.Get`, fieldName, `().GetNanos()))`)
+		if wrapper, ok := w.wrapperType(field); ok {
+			w.wrapperToGorm(fieldName, wrapper)
+		} else if w.wellKnownToGorm(field, fieldName) {
+		} else if typeName(field) == timestampType {
+			w.P(`// create time object, unset timestamp is stored as NULL`)
+			w.P(`if e.`, fieldName, ` != nil {`)
+			w.P(`ut`, fieldName, ` := e.`, fieldName, `.AsTime()`)
 			w.P(`resp.`, fieldName, ` = &ut`, fieldName)
 			w.P(`}`)
-			w.P(``)
-
-		} else if strings.EqualFold(goTyp, "*timestamppb.Timestamp") {
-			w.useTime = true
-			w.P(`// create time object`)
-			w.P(`ut`, fieldName, ` := time.Unix(e.`, fieldName, `.GetSeconds(), int64(e.`, fieldName, `.GetNanos()))`)
-			w.P(`resp.`, fieldName, ` = ut`, fieldName)
-		} else if field.IsMessage() {
-			repeated := field.IsRepeated()
+		} else if isMessage(field) {
+			repeated := isRepeated(field)
 			if repeated {
 				w.P(`// create nested mongo`)
 				w.P(`var sub`, fieldName, w.generateModelName(goTyp))
@@ -862,7 +1313,7 @@ func (w *WormPlugin) ToGormFields(field *descriptor.FieldDescriptorProto, messag
 		}
 	} else if jField != nil {
 
-		if field.IsRepeated() && jField.tps == "TYPE_STRING" {
+		if isRepeated(field) && jField.kind == protoreflect.StringKind {
 
 			w.P(`// convert to Gorm object json message`)
 			w.P(`var json = jsoniter.ConfigCompatibleWithStandardLibrary`)
@@ -876,73 +1327,51 @@ func (w *WormPlugin) ToGormFields(field *descriptor.FieldDescriptorProto, messag
 			w.P(`resp.`, fieldName, ` =  datatypes.JSON(e.`, fieldName, `)`)
 		}
 
+	} else if enum, ok := w.storedEnum(field); ok {
+		w.P(`resp.`, fieldName, ` = `, w.enumModelName(enum), `(e.`, fieldName, `)`)
 	} else {
-		if oneof {
-			sourceName := w.GetFieldName(message, field)
-			w.P(`// oneof link`)
-			w.P(`if e.Get`, sourceName, `() != nil {`)
-			w.P(`value :=  e.Get`, fieldName, `()`)
-			w.P(`resp.`, fieldName, ` = &value`)
-			w.P(`}`)
-			w.P(``)
-		} else {
-			w.P(`resp.`, fieldName, ` = e.`, fieldName)
-		}
+		w.P(`resp.`, fieldName, ` = e.`, fieldName)
 	}
-	w.Out()
 }
 
-func (w *WormPlugin) ToPBFields(field *descriptor.FieldDescriptorProto, message *generator.Descriptor, wGormFieldOptions *worm.WormFieldOptions) {
+func (w *WormPlugin) ToPBFields(field *protogen.Field, message *protogen.Message, wGormFieldOptions *worm.WormFieldOptions) {
 
-	name := w.generateModelName(message.GetName())
-	fieldName := field.GetName()
-	fieldName = generator.CamelCase(fieldName)
-	oneof := field.OneofIndex != nil
-	goTyp, _ := w.GoType(message, field)
-	w.In()
+	name := w.modelName(message)
+	fieldName := field.GoName
+	goTyp := w.goType(field)
 
 	var jField *JsonBField
 	if val, ok := w.JsonBFields[name+fieldName]; ok {
 		jField = &val
 	}
 
-	if w.IsMap(field) {
-		m, ism := w.goMapTypeCustomPB(nil, field)
-		_, keyField, keyAliasField := m.GoType, m.KeyField, m.KeyAliasField
-		keygoTyp, _ := w.GoType(nil, keyField)
-		keygoTyp = strings.Replace(keygoTyp, "*", "", 1)
-		keygoAliasTyp, _ := w.GoType(nil, keyAliasField)
-		keygoAliasTyp = strings.Replace(keygoAliasTyp, "*", "", 1)
-		w.P(`tt`, fieldName, ` := make(`, m.GoType, `)`)
+	if isMap(field) {
+		mapType, ism := w.goMapTypeCustomPB(field)
+		w.P(`tt`, fieldName, ` := make(`, mapType, `)`)
 		w.P(`for k, v := range e.`, fieldName, ` {`)
-		w.In()
 		if ism {
+			w.checkModelReference(message, field.Message.Fields[1])
 			w.P(`tt`, fieldName, `[k] = v.ToPB()`)
 		} else {
 			w.P(`tt`, fieldName, `[k] = v`)
 		}
-		w.Out()
 		w.P(`}`)
 		w.P(`resp.`, fieldName, ` = tt`, fieldName)
 
-	} else if (field.IsMessage() && !gogoproto.IsCustomType(field) && !gogoproto.IsStdType(field)) || w.IsGroup(field) {
-
-		if strings.EqualFold(goTyp, "*timestamppb.Timestamp") && oneof {
-
-			sourceName := w.GetFieldName(message, field)
-			interfaceName := w.Generator.OneOfTypeName(message, field)
-			w.P(`ptap`, fieldName, `, _ := ptypes.TimestampProto(e.Get`, fieldName, `())`)
-			w.useTime = true
-			w.P(`resp.`, sourceName, ` = &`, interfaceName, `{ptap`, fieldName, `}`)
-
-		} else if strings.EqualFold(goTyp, "*timestamppb.Timestamp") && !oneof {
+	} else if isMessage(field) {
 
-			w.P(`ptap`, fieldName, `, _ := ptypes.TimestampProto(e.`, fieldName, `)`)
-			w.useTime = true
-			w.P(`resp.`, fieldName, ` = ptap`, fieldName)
+		if wrapper, ok := w.wrapperType(field); ok {
+			w.wrapperToPB(fieldName, wrapper)
+		} else if w.wellKnownToPB(field, fieldName) {
+		} else if typeName(field) == timestampType {
+			w.useTimestamp = true
+			w.P(`if e.`, fieldName, ` != nil {`)
+			w.P(`resp.`, fieldName, ` = timestamppb.New(*e.`, fieldName, `)`)
+			w.P(`}`)
 
-		} else if field.IsMessage() {
-			repeated := field.IsRepeated()
+		} else if isMessage(field) {
+			w.checkModelReference(message, field)
+			repeated := isRepeated(field)
 			if repeated {
 				w.P(`// create nested pb`)
 				w.P(`var sub`, fieldName, goTyp)
@@ -965,7 +1394,7 @@ func (w *WormPlugin) ToPBFields(field *descriptor.FieldDescriptorProto, message
 		}
 	} else if jField != nil {
 
-		if field.IsRepeated() && jField.tps == "TYPE_STRING" {
+		if isRepeated(field) && jField.kind == protoreflect.StringKind {
 
 			w.P(`// convert jsonb to string`)
 			w.P(`var `, fieldName, `Str []string`)
@@ -983,55 +1412,57 @@ func (w *WormPlugin) ToPBFields(field *descriptor.FieldDescriptorProto, message
 			w.P(`resp.`, fieldName, ` = string(`, fieldName, `JsonbString)`)
 		}
 
+	} else if enum, ok := w.storedEnum(field); ok {
+		w.P(`resp.`, fieldName, ` = `, w.goIdent(enum.GoIdent), `(e.`, fieldName, `)`)
 	} else {
-		if oneof {
-			sourceName := w.GetFieldName(message, field)
-			interfaceName := w.Generator.OneOfTypeName(message, field)
-			w.P(`resp.`, sourceName, ` = &`, interfaceName, `{e.Get`, fieldName, `()}`)
-		} else {
-			w.P(`resp.`, fieldName, ` = e.`, fieldName)
-		}
+		w.P(`resp.`, fieldName, ` = e.`, fieldName)
 	}
-	w.Out()
 }
 
-func (w *WormPlugin) GenerateTableName(msg *generator.Descriptor) {
-	mName := w.generateModelName(msg.GetName())
+func (w *WormPlugin) GenerateTableName(msg *protogen.Message) {
+	mName := w.modelName(msg)
 	message, ok := w.getMessageOptions(msg)
 	if ok {
 		if model := message.GetModel(); model {
-			tableName := strings.ToLower(msg.GetName())
-			if table := message.GetTable(); len(table) > 0 && message.GetMigrate() {
-				tableName = table
-			}
 			w.P(`func (e *`, mName, `) TableName() string {`)
-			w.P(`return "`, tableName, `"`)
+			w.P(`return "`, w.tableName(msg), `"`)
 			w.P(`}`)
 		}
 	}
 }
 
 func (w *WormPlugin) generateEntitiesMethods() {
+	// map order is random, the methods are generated in the order of the names
 	if len(w.PrivateEntities) > 0 {
-		for key, value := range w.PrivateEntities {
+		keys := make([]string, 0, len(w.PrivateEntities))
+		for key := range w.PrivateEntities {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := w.PrivateEntities[key]
 			w.P(``)
 			w.P(`// Merge - merge private structure (`, value.name, `)`)
 			w.P(`func (e *`, value.name, `) Merge`, strings.Trim(key, " "), ` (m *`, key, `) *`, value.name, ` {`)
 
 			for _, field := range value.items {
-				fieldName := field.GetName()
-				fieldName = generator.CamelCase(fieldName)
+				fieldName := field.GoName
 				w.P(`e.`, fieldName, ` = m.`, fieldName)
 			}
 
 			w.P(`return e`)
 			w.P(`}`)
-			w.Out()
 			w.P(``)
 		}
 	}
 	if len(w.ConvertEntities) > 0 {
-		for _, value := range w.ConvertEntities {
+		keys := make([]string, 0, len(w.ConvertEntities))
+		for key := range w.ConvertEntities {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := w.ConvertEntities[key]
 			w.P(``)
 			w.P(`// To`, strings.Trim(value.nameTo, " "), ` - convert structure (`, value.nameFrom, ` -> `, value.nameTo, `)`)
 			w.P(`func (e *`, value.nameFrom, `) To`, strings.Trim(value.nameTo, " "), ` () *`, value.nameTo, ` {`)
@@ -1041,7 +1472,7 @@ func (w *WormPlugin) generateEntitiesMethods() {
 					for _, field := range fieldsFrom {
 						var isJsonb bool
 						for _, f := range fieldsTo {
-							if field.GetName() == f.GetName() {
+							if string(field.Desc.Name()) == string(f.Desc.Name()) {
 
 								wgromField := w.getFieldOptions(f)
 								if wgromField != nil && wgromField.Tag != nil {
@@ -1049,8 +1480,7 @@ func (w *WormPlugin) generateEntitiesMethods() {
 								}
 								if isJsonb {
 
-									fieldName := field.GetName()
-									fieldName = generator.CamelCase(fieldName)
+									fieldName := field.GoName
 
 									w.P(`// convert jsonb from []`)
 									w.P(`var json = jsoniter.ConfigCompatibleWithStandardLibrary`)
@@ -1061,16 +1491,20 @@ func (w *WormPlugin) generateEntitiesMethods() {
 								}
 
 								// skip if not equal oneOf
-								oneoField := field.OneofIndex != nil
-								oneoF := f.OneofIndex != nil
+								oneoField := field.Oneof != nil
+								oneoF := f.Oneof != nil
 								if oneoF {
 									if !oneoField {
 										continue
 									}
 								}
 
-								fieldName := field.GetName()
-								fieldName = generator.CamelCase(fieldName)
+								fieldName := field.GoName
+								// oneof member into the plain field
+								if !oneoF && w.hasOneOfGetter(field) {
+									w.P(`entity.`, fieldName, ` = e.Get`, fieldName, `()`)
+									continue
+								}
 								w.P(`entity.`, fieldName, ` = e.`, fieldName)
 							}
 						}
@@ -1082,9 +1516,8 @@ func (w *WormPlugin) generateEntitiesMethods() {
 					for _, f1 := range pe.items {
 						if fieldsFrom, ok := w.Fields[value.nameFrom]; ok {
 							for _, f2 := range fieldsFrom {
-								if f1.GetName() == f2.GetName() {
-									fieldName := f1.GetName()
-									fieldName = generator.CamelCase(fieldName)
+								if string(f1.Desc.Name()) == string(f2.Desc.Name()) {
+									fieldName := f1.GoName
 									w.P(`entity.`, fieldName, ` = e.`, fieldName)
 								}
 							}
@@ -1094,23 +1527,21 @@ func (w *WormPlugin) generateEntitiesMethods() {
 			}
 			w.P(`return &entity`)
 			w.P(`}`)
-			w.Out()
 			w.P(``)
 		}
 	}
 }
 
-func (w *WormPlugin) geterateGormMethods(msg *generator.Descriptor) {
-	mName := w.generateModelName(msg.GetName())
+func (w *WormPlugin) geterateGormMethods(msg *protogen.Message) {
+	mName := w.modelName(msg)
 	db := w.nameWithServicePrefix("DB")
 	message, ok := w.getMessageOptions(msg)
 	if ok {
 		if model := message.GetModel(); model {
 
-			w.P(`// New`, mName, ` create `, mName, ` gorm model of protobuf `, msg.GetName())
+			w.P(`// New`, mName, ` create `, mName, ` gorm model of protobuf `, w.messageName(msg))
 			w.P(`func New`, mName, `() *`, mName, ` {`)
 			w.P(`var e `, mName, ``)
-			w.P(`e.gorm = e.G()`)
 			w.P(`return &e`)
 			w.P(`}`)
 			w.P(``)
@@ -1130,20 +1561,38 @@ func (w *WormPlugin) geterateGormMethods(msg *generator.Descriptor) {
 
 			w.P(`// SetGorm setter custom gorm object`)
 			w.P(`func (e *`, mName, `) SetGorm(db *gorm.DB) *`, mName, ` {`)
-			w.P(`e.gorm = `, db, `.Table(e.TableName())`)
+			w.P(`e.gorm = db.Table(e.TableName())`)
 			w.P(`return e`)
 			w.P(`}`)
 			w.P(``)
 
-			w.P(`// Gorm getter gorm object with table name`)
+			w.P(`// Gorm getter gorm object with table name,`)
+			w.P(`// falls back to the global `, db, ` when the model is not bound to a data store`)
 			w.P(`func (e *`, mName, `) G() *gorm.DB {`)
-			w.P(`if e.gorm == nil {`)
-			w.P(`e.gorm = `, w.nameWithServicePrefix("DB"), `.Table(e.TableName())`)
+			w.P(`if e.gorm == nil && `, db, ` != nil {`)
+			w.P(`e.gorm = `, db, `.Table(e.TableName())`)
 			w.P(`}`)
 			w.P(`return e.gorm`)
 			w.P(`}`)
 			w.P(``)
 
+			w.useCtx = true
+			w.P(`// WithContext bind gorm object to the context`)
+			if w.useTxn {
+				w.P(`// the transaction opened by `, w.nameWithServicePrefix("DataStore"), `.TxnInterceptor is used if the context carries one`)
+			}
+			w.P(`func (e *`, mName, `) WithContext(ctx context.Context) *`, mName, ` {`)
+			if w.useTxn {
+				w.P(`if tx, ok := `, w.txnFromContext(), `(ctx); ok {`)
+				w.P(`e.gorm = tx.Table(e.TableName())`)
+				w.P(`return e`)
+				w.P(`}`)
+			}
+			w.P(`e.gorm = e.G().WithContext(ctx)`)
+			w.P(`return e`)
+			w.P(`}`)
+			w.P(``)
+
 		}
 	}
 }
@@ -0,0 +1,61 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// wormOptionsPackage - proto package of the worm options, files importing it are generated by the plugin
+const wormOptionsPackage = "worm"
+
+// isWormFile - check if the file imports the worm options, its messages have models in the .pb.worm.go of the same go package
+func (w *WormPlugin) isWormFile(name string) bool {
+	file, ok := w.gen.FilesByPath[name]
+	if !ok {
+		return false
+	}
+	imports := file.Desc.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		if imports.Get(i).Package() == wormOptionsPackage {
+			return true
+		}
+	}
+	return false
+}
+
+// referencedMessage - message of the field, it may be declared in another file or go package
+func (w *WormPlugin) referencedMessage(field *protogen.Field) (*protogen.Message, bool) {
+	if !isMessage(field) || field.Message == nil {
+		return nil, false
+	}
+	return field.Message, true
+}
+
+// checkWormReference - message of the field has a model only when its file is generated by the plugin
+func (w *WormPlugin) checkWormReference(message *protogen.Message, field *protogen.Field) {
+	if ref, ok := w.referencedMessage(field); ok && !w.isWormFile(ref.Desc.ParentFile().Path()) {
+		w.Fail(fmt.Sprintf("field %s.%s: message %s is declared in %s which does not import the worm options, it has no model",
+			message.Desc.Name(), field.Desc.Name(), strings.TrimPrefix(typeName(field), "."), ref.Desc.ParentFile().Path()))
+	}
+}
+
+// messageModelType - model type of the message field, qualified with the go package of the message (*other.RoleWORM)
+func (w *WormPlugin) messageModelType(message *protogen.Message, field *protogen.Field) string {
+	w.checkWormReference(message, field)
+	return w.generateModelName(w.goType(field))
+}
+
+// checkModelReference - nested message of the model is converted by its own ToPB and ToGorm, it has to be a model
+func (w *WormPlugin) checkModelReference(message *protogen.Message, field *protogen.Field) {
+	ref, ok := w.referencedMessage(field)
+	if !ok {
+		return
+	}
+	if opt, ok := w.getMessageOptions(ref); ok && opt.GetModel() {
+		return
+	}
+	w.Fail(fmt.Sprintf("field %s.%s: message %s is not a model, nested messages of the models have to be models",
+		message.Desc.Name(), field.Desc.Name(), strings.TrimPrefix(typeName(field), ".")))
+}
@@ -0,0 +1,377 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// Schema - snapshot of the generated model schema, stored between generations to diff the models
+type Schema struct {
+	Driver string         `json:"driver"`
+	Enums  []*SchemaEnum  `json:"enums,omitempty"`
+	Tables []*SchemaTable `json:"tables"`
+}
+
+// schemaChange - difference of the model schema with up and down statements
+type schemaChange struct {
+	text        string
+	destructive bool
+	up          []string
+	down        []string
+}
+
+// readSchemaSnapshot - previously generated schema, empty schema when the snapshot does not exist yet,
+// the snapshot is written to the output directory, it is read from there through the SchemaDir parameter
+func (w *WormPlugin) readSchemaSnapshot(name string) *Schema {
+	schema := &Schema{Driver: w.GetDBDriver()}
+	bts, err := ioutil.ReadFile(name)
+	if os.IsNotExist(err) {
+		return schema
+	}
+	if err != nil {
+		w.Fail(fmt.Sprintf("read schema snapshot %s: %v", name, err))
+		return schema
+	}
+	if err := json.Unmarshal(bts, schema); err != nil {
+		w.Fail(fmt.Sprintf("parse schema snapshot %s: %v", name, err))
+		return schema
+	}
+	if schema.Driver != w.GetDBDriver() {
+		w.Fail(fmt.Sprintf("schema snapshot %s is generated for %s, current driver is %s", name, schema.Driver, w.GetDBDriver()))
+	}
+	return schema
+}
+
+func findSchemaTable(tables []*SchemaTable, name string) *SchemaTable {
+	for _, table := range tables {
+		if table.Name == name {
+			return table
+		}
+	}
+	return nil
+}
+
+func findSchemaEnum(enums []*SchemaEnum, name string) *SchemaEnum {
+	for _, enum := range enums {
+		if enum.Name == name {
+			return enum
+		}
+	}
+	return nil
+}
+
+func findSchemaColumn(table *SchemaTable, name string) *SchemaColumn {
+	for _, column := range table.Columns {
+		if column.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+func findSchemaIndex(table *SchemaTable, name string) *SchemaIndex {
+	for _, index := range table.Indexes {
+		if index.Name == name {
+			return index
+		}
+	}
+	return nil
+}
+
+func sameIndex(a, b *SchemaIndex) bool {
+	return a.Unique == b.Unique && strings.Join(a.Columns, ",") == strings.Join(b.Columns, ",")
+}
+
+// diffSchema - changes turning the old schema into the new one, tables are created when there is no old schema
+func (w *WormPlugin) diffSchema(old, new *Schema) []schemaChange {
+	var changes []schemaChange
+	var oldTables []*SchemaTable
+	var oldEnums []*SchemaEnum
+	if old != nil {
+		oldTables = old.Tables
+		oldEnums = old.Enums
+	}
+
+	// enum types are created before the tables using them
+	for _, enum := range new.Enums {
+		prev := findSchemaEnum(oldEnums, enum.Name)
+		if prev == nil {
+			changes = append(changes, schemaChange{
+				text: "+ enum " + enum.Name,
+				up:   []string{w.createEnumStatement(enum)},
+				down: []string{fmt.Sprintf("DROP TYPE IF EXISTS %s;\n", w.quoteIdent(enum.Name))},
+			})
+			continue
+		}
+		changes = append(changes, w.diffEnum(prev, enum)...)
+	}
+
+	for _, table := range new.Tables {
+		prev := findSchemaTable(oldTables, table.Name)
+		if prev == nil {
+			changes = append(changes, schemaChange{
+				text: "+ table " + table.Name,
+				up:   []string{w.createTableStatements(table)},
+				down: []string{fmt.Sprintf("DROP TABLE IF EXISTS %s;\n", w.quoteIdent(table.Name))},
+			})
+			continue
+		}
+		changes = append(changes, w.diffTable(prev, table)...)
+	}
+
+	for _, table := range oldTables {
+		if findSchemaTable(new.Tables, table.Name) == nil {
+			changes = append(changes, schemaChange{
+				text:        "- table " + table.Name,
+				destructive: true,
+				up:          []string{fmt.Sprintf("DROP TABLE IF EXISTS %s;\n", w.quoteIdent(table.Name))},
+				down:        []string{w.createTableStatements(table)},
+			})
+		}
+	}
+
+	for _, enum := range oldEnums {
+		if findSchemaEnum(new.Enums, enum.Name) == nil {
+			changes = append(changes, schemaChange{
+				text:        "- enum " + enum.Name,
+				destructive: true,
+				up:          []string{fmt.Sprintf("DROP TYPE IF EXISTS %s;\n", w.quoteIdent(enum.Name))},
+				down:        []string{w.createEnumStatement(enum)},
+			})
+		}
+	}
+	return changes
+}
+
+// diffEnum - values of the native enum, postgres can add values but can not drop them
+func (w *WormPlugin) diffEnum(old, new *SchemaEnum) []schemaChange {
+	var changes []schemaChange
+	for _, value := range new.Values {
+		if !containsString(old.Values, value) {
+			changes = append(changes, schemaChange{
+				text: "+ enum value " + new.Name + "." + value,
+				up:   []string{fmt.Sprintf("ALTER TYPE %s ADD VALUE IF NOT EXISTS '%s';\n", w.quoteIdent(new.Name), value)},
+				down: []string{fmt.Sprintf("-- postgres can not drop value %s of enum %s\n", value, new.Name)},
+			})
+		}
+	}
+	for _, value := range old.Values {
+		if !containsString(new.Values, value) {
+			changes = append(changes, schemaChange{
+				text:        "- enum value " + new.Name + "." + value,
+				destructive: true,
+				up:          []string{fmt.Sprintf("-- postgres can not drop value %s of enum %s, the type has to be rebuilt\n", value, new.Name)},
+				down:        []string{fmt.Sprintf("ALTER TYPE %s ADD VALUE IF NOT EXISTS '%s';\n", w.quoteIdent(new.Name), value)},
+			})
+		}
+	}
+	return changes
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// diffTable - column and index changes of the table
+func (w *WormPlugin) diffTable(old, new *SchemaTable) []schemaChange {
+	var changes []schemaChange
+	name := new.Name
+
+	for _, index := range old.Indexes {
+		if next := findSchemaIndex(new, index.Name); next == nil || !sameIndex(index, next) {
+			changes = append(changes, schemaChange{
+				text: "- index " + name + "." + index.Name,
+				up:   []string{w.dropIndexStatement(old, index)},
+				down: []string{w.createIndexStatement(old, index)},
+			})
+		}
+	}
+
+	for _, column := range new.Columns {
+		prev := findSchemaColumn(old, column.Name)
+		if prev == nil {
+			changes = append(changes, schemaChange{
+				text: "+ column " + name + "." + column.Name + " " + column.Type,
+				up:   []string{w.addColumnStatement(new, column)},
+				down: []string{w.dropColumnStatement(new, column)},
+			})
+			continue
+		}
+		if prev.Type == column.Type && prev.Nullable == column.Nullable {
+			if prev.Default == column.Default {
+				continue
+			}
+			text := fmt.Sprintf("~ column %s.%s default %q -> %q", name, column.Name, prev.Default, column.Default)
+			if !w.canAlterDefault() {
+				// the change is reported, the migration is not written for it
+				changes = append(changes, schemaChange{text: text + ", not migrated"})
+				continue
+			}
+			changes = append(changes, schemaChange{
+				text: text,
+				up:   []string{w.alterColumnStatement(new, prev, column)},
+				down: []string{w.alterColumnStatement(old, column, prev)},
+			})
+			continue
+		}
+		changes = append(changes, schemaChange{
+			text: fmt.Sprintf("~ column %s.%s %s -> %s", name, column.Name, w.columnSummary(prev), w.columnSummary(column)),
+			// retyped columns may lose data, new not null constraint fails on existing nulls
+			destructive: prev.Type != column.Type || (prev.Nullable && !column.Nullable),
+			up:          []string{w.alterColumnStatement(new, prev, column)},
+			down:        []string{w.alterColumnStatement(old, column, prev)},
+		})
+	}
+
+	for _, column := range old.Columns {
+		if findSchemaColumn(new, column.Name) == nil {
+			changes = append(changes, schemaChange{
+				text:        "- column " + name + "." + column.Name + " " + column.Type,
+				destructive: true,
+				up:          []string{w.dropColumnStatement(old, column)},
+				down:        []string{w.addColumnStatement(old, column)},
+			})
+		}
+	}
+
+	for _, index := range new.Indexes {
+		if prev := findSchemaIndex(old, index.Name); prev == nil || !sameIndex(prev, index) {
+			changes = append(changes, schemaChange{
+				text: "+ index " + name + "." + index.Name,
+				up:   []string{w.createIndexStatement(new, index)},
+				down: []string{w.dropIndexStatement(new, index)},
+			})
+		}
+	}
+	return changes
+}
+
+func (w *WormPlugin) columnSummary(column *SchemaColumn) string {
+	if column.Nullable {
+		return column.Type + " null"
+	}
+	return column.Type + " not null"
+}
+
+func (w *WormPlugin) addColumnStatement(table *SchemaTable, column *SchemaColumn) string {
+	switch w.GetDBDriver() {
+	case "mssql", "sqlserver":
+		return fmt.Sprintf("ALTER TABLE %s ADD %s;\n", w.quoteIdent(table.Name), w.columnDefinition(column))
+	}
+	return fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;\n", w.quoteIdent(table.Name), w.columnDefinition(column))
+}
+
+func (w *WormPlugin) dropColumnStatement(table *SchemaTable, column *SchemaColumn) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;\n", w.quoteIdent(table.Name), w.quoteIdent(column.Name))
+}
+
+// alterColumnStatement - driver specific change of the column type and nullability
+func (w *WormPlugin) alterColumnStatement(table *SchemaTable, from, to *SchemaColumn) string {
+	tableName := w.quoteIdent(table.Name)
+	column := w.quoteIdent(to.Name)
+	switch w.GetDBDriver() {
+	case "mysql":
+		return fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s;\n", tableName, w.columnDefinition(to))
+	case "mssql", "sqlserver":
+		null := " NULL"
+		if !to.Nullable {
+			null = " NOT NULL"
+		}
+		return fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s%s;\n", tableName, column, to.Type, null)
+	case "sqlite":
+		return fmt.Sprintf("-- sqlite can not alter column %s of %s (%s -> %s), the table has to be rebuilt\n",
+			to.Name, table.Name, w.columnSummary(from), w.columnSummary(to))
+	}
+	var actions []string
+	if from.Type != to.Type {
+		actions = append(actions, fmt.Sprintf("ALTER COLUMN %s TYPE %s USING %s::%s", column, to.Type, column, to.Type))
+	}
+	if from.Nullable != to.Nullable {
+		if to.Nullable {
+			actions = append(actions, fmt.Sprintf("ALTER COLUMN %s DROP NOT NULL", column))
+		} else {
+			actions = append(actions, fmt.Sprintf("ALTER COLUMN %s SET NOT NULL", column))
+		}
+	}
+	if from.Default != to.Default {
+		if len(to.Default) > 0 {
+			actions = append(actions, fmt.Sprintf("ALTER COLUMN %s SET DEFAULT %s", column, to.Default))
+		} else {
+			actions = append(actions, fmt.Sprintf("ALTER COLUMN %s DROP DEFAULT", column))
+		}
+	}
+	return fmt.Sprintf("ALTER TABLE %s %s;\n", tableName, strings.Join(actions, ", "))
+}
+
+// canAlterDefault - postgres alters the default and mysql modifies the whole column,
+// sqlserver keeps the default in a named constraint and sqlite can not alter columns
+func (w *WormPlugin) canAlterDefault() bool {
+	switch w.GetDBDriver() {
+	case "postgres", "mysql":
+		return true
+	}
+	return false
+}
+
+// hasStatements - some of the changes are migrated
+func hasStatements(changes []schemaChange) bool {
+	for _, change := range changes {
+		if len(change.up) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *WormPlugin) dropIndexStatement(table *SchemaTable, index *SchemaIndex) string {
+	switch w.GetDBDriver() {
+	case "mysql", "mssql", "sqlserver":
+		return fmt.Sprintf("DROP INDEX %s ON %s;\n", w.quoteIdent(index.Name), w.quoteIdent(table.Name))
+	}
+	return fmt.Sprintf("DROP INDEX IF EXISTS %s;\n", w.quoteIdent(index.Name))
+}
+
+// schemaSnapshotFiles - updated snapshot and diff report, fails on destructive changes unless they are allowed
+func (w *WormPlugin) schemaSnapshotFiles(schema *Schema, changes []schemaChange) {
+	var destructive []string
+	var report strings.Builder
+	fmt.Fprintf(&report, "schema diff against %s (%s)\n\n", w.SchemaSnapshot, w.GetDBDriver())
+	if len(changes) == 0 {
+		report.WriteString("no changes\n")
+	}
+	for _, change := range changes {
+		report.WriteString(change.text)
+		if change.destructive {
+			report.WriteString(" (destructive)")
+			destructive = append(destructive, strings.TrimSpace(change.text))
+		}
+		report.WriteString("\n")
+	}
+	if len(changes) > 0 {
+		report.WriteString("\n-- up\n")
+		for _, change := range changes {
+			report.WriteString(strings.Join(change.up, ""))
+		}
+	}
+	if len(destructive) > 0 && !w.AllowDestructive {
+		w.Fail(fmt.Sprintf("destructive schema changes (set AllowDestructive=true to accept them): %s", strings.Join(destructive, "; ")))
+		return
+	}
+
+	bts, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		w.Fail(fmt.Sprintf("encode schema snapshot: %v", err))
+		return
+	}
+	reportName := strings.TrimSuffix(w.SchemaSnapshot, ".json") + ".diff.txt"
+	w.writeFile(w.SchemaSnapshot, string(bts)+"\n")
+	w.writeFile(reportName, report.String())
+}
@@ -0,0 +1,221 @@
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// usersTable - table of the diff cases, the cases change a copy of it
+func usersTable() *SchemaTable {
+	return &SchemaTable{
+		Name: "users",
+		Columns: []*SchemaColumn{
+			{Name: "id", Type: "bigint", PrimaryKey: true},
+			{Name: "name", Type: "text", Nullable: true},
+			{Name: "score", Type: "integer", Nullable: true, Default: "0"},
+		},
+		Indexes: []*SchemaIndex{{Name: "idx_users_name", Columns: []string{"name"}}},
+	}
+}
+
+// diffCases - changes of the users table, text and up are the reports and the statements of the changes
+var diffCases = []struct {
+	name        string
+	driver      string
+	change      func(schema *Schema)
+	text        []string
+	up          []string
+	destructive bool
+}{
+	{
+		name:   "no changes",
+		driver: "postgres",
+		change: func(schema *Schema) {},
+	},
+	{
+		name:   "new table",
+		driver: "postgres",
+		change: func(schema *Schema) {
+			schema.Tables = append(schema.Tables, &SchemaTable{Name: "roles", Columns: []*SchemaColumn{{Name: "id", Type: "bigint", PrimaryKey: true}}})
+		},
+		text: []string{"+ table roles"},
+		up:   []string{`CREATE TABLE "roles" (`},
+	},
+	{
+		name:        "dropped table",
+		driver:      "postgres",
+		change:      func(schema *Schema) { schema.Tables = nil },
+		text:        []string{"- table users"},
+		up:          []string{`DROP TABLE IF EXISTS "users";`},
+		destructive: true,
+	},
+	{
+		name:   "new column",
+		driver: "mysql",
+		change: func(schema *Schema) {
+			table := schema.Tables[0]
+			table.Columns = append(table.Columns, &SchemaColumn{Name: "email", Type: "varchar(255)", Nullable: true})
+		},
+		text: []string{"+ column users.email varchar(255)"},
+		up:   []string{"ALTER TABLE `users` ADD COLUMN `email` varchar(255);"},
+	},
+	{
+		name:        "dropped column",
+		driver:      "postgres",
+		change:      func(schema *Schema) { schema.Tables[0].Columns = schema.Tables[0].Columns[:2] },
+		text:        []string{"- column users.score integer"},
+		up:          []string{`ALTER TABLE "users" DROP COLUMN "score";`},
+		destructive: true,
+	},
+	{
+		name:        "retyped column",
+		driver:      "postgres",
+		change:      func(schema *Schema) { schema.Tables[0].Columns[2].Type = "bigint" },
+		text:        []string{"~ column users.score integer null -> bigint null"},
+		up:          []string{`ALTER TABLE "users" ALTER COLUMN "score" TYPE bigint USING "score"::bigint;`},
+		destructive: true,
+	},
+	{
+		name:        "not null column",
+		driver:      "sqlserver",
+		change:      func(schema *Schema) { schema.Tables[0].Columns[1].Nullable = false },
+		text:        []string{"~ column users.name text null -> text not null"},
+		up:          []string{"ALTER TABLE [users] ALTER COLUMN [name] text NOT NULL;"},
+		destructive: true,
+	},
+	{
+		name:   "default postgres",
+		driver: "postgres",
+		change: func(schema *Schema) { schema.Tables[0].Columns[2].Default = "10" },
+		text:   []string{`~ column users.score default "0" -> "10"`},
+		up:     []string{`ALTER TABLE "users" ALTER COLUMN "score" SET DEFAULT 10;`},
+	},
+	{
+		name:   "dropped default postgres",
+		driver: "postgres",
+		change: func(schema *Schema) { schema.Tables[0].Columns[2].Default = "" },
+		text:   []string{`~ column users.score default "0" -> ""`},
+		up:     []string{`ALTER TABLE "users" ALTER COLUMN "score" DROP DEFAULT;`},
+	},
+	{
+		name:   "default mysql",
+		driver: "mysql",
+		change: func(schema *Schema) { schema.Tables[0].Columns[2].Default = "10" },
+		text:   []string{`~ column users.score default "0" -> "10"`},
+		up:     []string{"ALTER TABLE `users` MODIFY COLUMN `score` integer DEFAULT 10;"},
+	},
+	{
+		name:   "default sqlite",
+		driver: "sqlite",
+		change: func(schema *Schema) { schema.Tables[0].Columns[2].Default = "10" },
+		text:   []string{`~ column users.score default "0" -> "10", not migrated`},
+	},
+	{
+		name:   "changed index",
+		driver: "postgres",
+		change: func(schema *Schema) { schema.Tables[0].Indexes[0].Unique = true },
+		text:   []string{"- index users.idx_users_name", "+ index users.idx_users_name"},
+		up:     []string{`DROP INDEX IF EXISTS "idx_users_name";`, `CREATE UNIQUE INDEX`},
+	},
+	{
+		name:   "enum value",
+		driver: "postgres",
+		change: func(schema *Schema) { schema.Enums[0].Values = append(schema.Enums[0].Values, "BLOCKED") },
+		text:   []string{"+ enum value user_status.BLOCKED"},
+		up:     []string{`ALTER TYPE "user_status" ADD VALUE IF NOT EXISTS 'BLOCKED';`},
+	},
+}
+
+func TestDiffSchema(t *testing.T) {
+	for _, tc := range diffCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			w := NewWormPlugin()
+			w.Config = NewConfig()
+			w.DBDriver = tc.driver
+			newSchema := func() *Schema {
+				return &Schema{
+					Driver: tc.driver,
+					Enums:  []*SchemaEnum{{Name: "user_status", Values: []string{"ACTIVE"}}},
+					Tables: []*SchemaTable{usersTable()},
+				}
+			}
+			next := newSchema()
+			tc.change(next)
+			changes := w.diffSchema(newSchema(), next)
+
+			var text, up []string
+			var destructive bool
+			for _, change := range changes {
+				text = append(text, change.text)
+				up = append(up, change.up...)
+				destructive = destructive || change.destructive
+			}
+			if strings.Join(text, "\n") != strings.Join(tc.text, "\n") {
+				t.Errorf("changes %q, want %q", text, tc.text)
+			}
+			if len(up) != len(tc.up) {
+				t.Fatalf("up statements %q, want %q", up, tc.up)
+			}
+			for i, statement := range tc.up {
+				if !strings.Contains(up[i], statement) {
+					t.Errorf("up statement %q, want %q", up[i], statement)
+				}
+			}
+			if destructive != tc.destructive {
+				t.Errorf("destructive %v, want %v", destructive, tc.destructive)
+			}
+			if hasStatements(changes) != (len(tc.up) > 0) {
+				t.Errorf("hasStatements %v, want %v", hasStatements(changes), len(tc.up) > 0)
+			}
+		})
+	}
+}
+
+// TestSchemaSnapshot - the snapshot written to the output directory is read back through SchemaDir
+func TestSchemaSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "worm-schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	param := "DBDriver=sqlite,Migrations=migrations,SchemaSnapshot=schema/snapshot.json,SchemaDir=" + dir
+
+	files := func() map[string]string {
+		resp := runPlugin(t, goldenDir, "convert", param)
+		if resp.Error != nil {
+			t.Fatalf("generate: %s", resp.GetError())
+		}
+		files := make(map[string]string)
+		for _, file := range resp.GetFile() {
+			files[file.GetName()] = file.GetContent()
+		}
+		return files
+	}
+
+	first := files()
+	if _, ok := first["migrations/1_worm.up.sql"]; !ok {
+		t.Fatal("migration of the new tables is expected")
+	}
+	snapshot, ok := first["schema/snapshot.json"]
+	if !ok {
+		t.Fatal("schema snapshot is expected")
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "schema"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "schema", "snapshot.json"), []byte(snapshot), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	second := files()
+	if _, ok := second["migrations/1_worm.up.sql"]; ok {
+		t.Error("no migration is expected against the stored snapshot")
+	}
+	if report := second["schema/snapshot.diff.txt"]; !strings.Contains(report, "no changes") {
+		t.Errorf("diff report %q, want no changes", report)
+	}
+}
@@ -0,0 +1,382 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	worm "github.com/cjp2600/protoc-gen-worm/plugin/options"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// crud operations which can be inferred for the auto generated server methods
+const (
+	operationNone   = ""
+	operationCreate = "create"
+	operationGet    = "get"
+	operationList   = "list"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
+// method name prefixes used to infer the crud operation, list is checked first
+// because "GetAll" must not be treated as a simple get.
+var operationPrefixes = []struct {
+	operation string
+	prefixes  []string
+}{
+	{operationList, []string{"List", "GetAll", "All", "Search"}},
+	{operationCreate, []string{"Create", "Add", "Insert", "Register"}},
+	{operationUpdate, []string{"Update", "Edit", "Patch"}},
+	{operationDelete, []string{"Delete", "Remove"}},
+	{operationGet, []string{"Get", "Find", "Read", "Fetch"}},
+}
+
+func (w *WormPlugin) getServiceOptions(svc *protogen.Service) (*worm.AutoServerOptions, bool) {
+	opts, ok := proto.GetExtension(svc.Desc.Options(), worm.E_Server).(*worm.AutoServerOptions)
+	return opts, ok && opts != nil
+}
+
+func (w *WormPlugin) getMethodOptions(method *protogen.Method) (*worm.MethodOptions, bool) {
+	opts, ok := proto.GetExtension(method.Desc.Options(), worm.E_Method).(*worm.MethodOptions)
+	return opts, ok && opts != nil
+}
+
+// messageByName - find message of the current file by its go name, nested messages are named with the parents (User_Address)
+func (w *WormPlugin) messageByName(file *protogen.File, name string) *protogen.Message {
+	for _, msg := range fileMessages(file) {
+		if w.messageName(msg) == name {
+			return msg
+		}
+	}
+	return nil
+}
+
+// isMessageOf - check that field refers to the given message
+func (w *WormPlugin) isMessageOf(field *protogen.Field, message *protogen.Message) bool {
+	return isMessage(field) && field.Message == message
+}
+
+// findObjectField - find field of the message which holds the object, repeated or not
+func (w *WormPlugin) findObjectField(message, object *protogen.Message, repeated bool) *protogen.Field {
+	for _, field := range message.Fields {
+		if isRepeated(field) == repeated && w.isMessageOf(field, object) {
+			return field
+		}
+	}
+	return nil
+}
+
+// findIdField - find identifier field of the request (id or <object>Id)
+func (w *WormPlugin) findIdField(message, object *protogen.Message) *protogen.Field {
+	for _, field := range message.Fields {
+		name := strings.ToLower(string(field.Desc.Name()))
+		if name == "id" || name == strings.ToLower(string(object.Desc.Name()))+"id" || name == strings.ToLower(string(object.Desc.Name()))+"_id" {
+			return field
+		}
+	}
+	return nil
+}
+
+// convertsTo - check that message is a model which can be converted to the object model
+func (w *WormPlugin) convertsTo(message, object *protogen.Message) bool {
+	opt, ok := w.getMessageOptions(message)
+	if !ok || !opt.GetModel() {
+		return false
+	}
+	for _, str := range strings.Split(opt.GetConvertTo(), ",") {
+		if strings.Trim(str, " ") == w.messageName(object) {
+			return true
+		}
+	}
+	return false
+}
+
+// modelFromRequest - expression which builds the object model from the request
+func (w *WormPlugin) modelFromRequest(input, object *protogen.Message) (string, bool) {
+	if input == object {
+		return `req.ToGorm()`, true
+	}
+	if field := w.findObjectField(input, object, false); field != nil {
+		return `req.Get` + field.GoName + `().ToGorm()`, true
+	}
+	if w.convertsTo(input, object) {
+		return `req.ToGorm().To` + w.modelName(object) + `()`, true
+	}
+	return "", false
+}
+
+// methodOperation - infer crud operation by the method name and request/response shapes
+func (w *WormPlugin) methodOperation(method *protogen.Method, input, output, object *protogen.Message) string {
+	for _, op := range operationPrefixes {
+		for _, prefix := range op.prefixes {
+			if strings.HasPrefix(method.GoName, prefix) {
+				return op.operation
+			}
+		}
+	}
+	if w.findObjectField(output, object, true) != nil {
+		return operationList
+	}
+	if _, ok := w.modelFromRequest(input, object); ok {
+		return operationCreate
+	}
+	if w.findIdField(input, object) != nil {
+		return operationGet
+	}
+	return operationNone
+}
+
+func (w *WormPlugin) generateServers(file *protogen.File) {
+	for _, svc := range file.Services {
+		opts, ok := w.getServiceOptions(svc)
+		if !ok || !opts.GetAutogen() {
+			continue
+		}
+		w.generateServer(file, svc)
+	}
+}
+
+func (w *WormPlugin) generateServer(file *protogen.File, svc *protogen.Service) {
+	name := w.generateModelName(string(svc.Desc.Name()) + "Server")
+	store := w.nameWithServicePrefix("DataStore")
+
+	unimplemented := w.goIdent(protogen.GoIdent{GoName: "Unimplemented" + svc.GoName + "Server", GoImportPath: file.GoImportPath})
+	server := w.goIdent(protogen.GoIdent{GoName: svc.GoName + "Server", GoImportPath: file.GoImportPath})
+
+	w.P()
+	w.P(`// `, name, ` - auto generated implementation of `, string(svc.Desc.Name()), `,`)
+	w.P(`// methods without the inferred operation are served by `, unimplemented)
+	w.P(`type `, name, ` struct {`)
+	w.P(unimplemented)
+	w.P()
+	w.P(`store *`, store)
+	w.P(`}`)
+	w.P()
+	w.P(`var _ `, server, ` = (*`, name, `)(nil)`)
+	w.P()
+	w.P(`// New`, name, ` - `, name, ` constructor, models are bound to the store connection`)
+	w.P(`func New`, name, `(store *`, store, `) *`, name, ` {`)
+	w.P(`return &`, name, `{store: store}`)
+	w.P(`}`)
+
+	for _, method := range svc.Methods {
+		w.generateServerMethod(file, name, method)
+	}
+}
+
+func (w *WormPlugin) generateServerMethod(file *protogen.File, serverName string, method *protogen.Method) {
+	if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+		w.Fail(fmt.Sprintf("method %s: streaming methods are not supported by autogen", method.Desc.Name()))
+		return
+	}
+
+	input := method.Input
+	output := method.Output
+	inputType := w.goIdent(input.GoIdent)
+	outputType := w.goIdent(output.GoIdent)
+
+	var object *protogen.Message
+	operation := operationNone
+	if opts, ok := w.getMethodOptions(method); ok && len(opts.GetObjectType()) > 0 {
+		object = w.messageByName(file, opts.GetObjectType())
+		if object == nil {
+			w.Fail(fmt.Sprintf("method %s: object_type %s not found", method.Desc.Name(), opts.GetObjectType()))
+			return
+		}
+		if msgOpts, ok := w.getMessageOptions(object); !ok || !msgOpts.GetModel() {
+			w.Fail(fmt.Sprintf("method %s: object_type %s is not a model", method.Desc.Name(), opts.GetObjectType()))
+			return
+		}
+		operation = w.methodOperation(method, input, output, object)
+	}
+
+	// object_type is not set or the operation can not be inferred, the embedded unimplemented server answers
+	if operation == operationNone {
+		return
+	}
+	w.useServer = true
+
+	w.P()
+	w.P(`// `, method.GoName, ` - `, operation, ` `, w.modelName(object))
+	w.P(`func (s *`, serverName, `) `, method.GoName, `(ctx context.Context, req *`, inputType, `) (*`, outputType, `, error) {`)
+
+	switch operation {
+	case operationCreate:
+		w.generateServerCreate(method, input, output, object)
+	case operationGet:
+		w.generateServerGet(method, input, output, object)
+	case operationList:
+		w.generateServerList(method, input, output, object)
+	case operationUpdate:
+		w.generateServerUpdate(method, input, output, object)
+	case operationDelete:
+		w.generateServerDelete(method, input, output, object)
+	}
+	w.P(`}`)
+}
+
+// serverResponse - print return of the response built from the item model
+func (w *WormPlugin) serverResponse(output, object *protogen.Message, item string) {
+	outputType := w.goIdent(output.GoIdent)
+	if output == object {
+		w.P(`return `, item, `.ToPB(), nil`)
+		return
+	}
+	if field := w.findObjectField(output, object, false); field != nil {
+		w.P(`return &`, outputType, `{`, field.GoName, `: `, item, `.ToPB()}, nil`)
+		return
+	}
+	w.P(`return &`, outputType, `{}, nil`)
+}
+
+// serverModel - print item model built from the request
+func (w *WormPlugin) serverModel(method *protogen.Method, input, object *protogen.Message) bool {
+	expr, ok := w.modelFromRequest(input, object)
+	if !ok {
+		w.Fail(fmt.Sprintf("method %s: request %s does not carry %s", method.Desc.Name(), input.Desc.Name(), object.Desc.Name()))
+		return false
+	}
+	if field := w.findObjectField(input, object, false); field != nil && input != object {
+		w.P(`if req.Get`, field.GoName, `() == nil {`)
+		w.P(`return nil, status.Error(codes.InvalidArgument, "`, string(field.Desc.Name()), ` is required")`)
+		w.P(`}`)
+	}
+	w.P(`item := `, expr, `.SetGorm(s.store.DB())`)
+	return true
+}
+
+// serverId - expression of the request identifier of the primary key type, numeric identifiers are converted
+func (w *WormPlugin) serverId(method *protogen.Method, input, object *protogen.Message) (string, bool) {
+	field := w.findIdField(input, object)
+	if field == nil {
+		w.Fail(fmt.Sprintf("method %s: request %s has no id field", method.Desc.Name(), input.Desc.Name()))
+		return "", false
+	}
+	pk := w.primaryKeyField(object)
+	if pk == nil {
+		w.Fail(fmt.Sprintf("method %s: model %s has no primary key", method.Desc.Name(), object.Desc.Name()))
+		return "", false
+	}
+	id := `req.Get` + field.GoName + `()`
+	idType, pkType := strings.TrimPrefix(w.goType(field), "*"), strings.TrimPrefix(w.goType(pk), "*")
+	switch {
+	case idType == pkType:
+		return id, true
+	case isNumeric(field) && isNumeric(pk) && !isRepeated(field):
+		return pkType + `(` + id + `)`, true
+	}
+	w.Fail(fmt.Sprintf("method %s: id field %s.%s of type %s does not match the primary key %s.%s of type %s",
+		method.Desc.Name(), input.Desc.Name(), field.Desc.Name(), idType, object.Desc.Name(), pk.Desc.Name(), pkType))
+	return "", false
+}
+
+func (w *WormPlugin) serverError(code string) {
+	w.P(`return nil, status.Error(codes.`, code, `, err.Error())`)
+}
+
+func (w *WormPlugin) generateServerCreate(method *protogen.Method, input, output, object *protogen.Message) {
+	if !w.serverModel(method, input, object) {
+		return
+	}
+	w.P(`if _, err := item.`, w.crudMethodName(object, "Create"), `(ctx); err != nil {`)
+	w.serverError("Internal")
+	w.P(`}`)
+	w.serverResponse(output, object, "item")
+}
+
+func (w *WormPlugin) generateServerGet(method *protogen.Method, input, output, object *protogen.Message) {
+	id, ok := w.serverId(method, input, object)
+	if !ok {
+		return
+	}
+	w.P(`item, err := s.store.`, w.messageName(object), `().`, w.crudMethodName(object, "GetByID"), `(ctx, `, id, `)`)
+	w.P(`if err != nil {`)
+	w.P(`if errors.Is(err, gorm.ErrRecordNotFound) {`)
+	w.serverError("NotFound")
+	w.P(`}`)
+	w.serverError("Internal")
+	w.P(`}`)
+	w.serverResponse(output, object, "item")
+}
+
+func (w *WormPlugin) generateServerList(method *protogen.Method, input, output, object *protogen.Message) {
+	field := w.findObjectField(output, object, true)
+	if field == nil {
+		w.Fail(fmt.Sprintf("method %s: response %s has no repeated %s field", method.Desc.Name(), output.Desc.Name(), object.Desc.Name()))
+		return
+	}
+	fieldName := field.GoName
+
+	pagination := w.findPaginationField(output)
+	if pagination != nil {
+		page, size := "0", "0"
+		if f := w.findScalarField(input, "page"); f != nil {
+			page = w.paginationParam(method, input, f)
+		}
+		if f := w.findScalarField(input, "size"); f != nil {
+			size = w.paginationParam(method, input, f)
+		}
+		w.P(`items, pagination, err := s.store.`, w.messageName(object), `().`, w.crudMethodName(object, "Paginate"), `(ctx, `, page, `, `, size, `)`)
+	} else {
+		w.P(`items, err := s.store.`, w.messageName(object), `().`, w.crudMethodName(object, "List"), `(ctx, nil)`)
+	}
+	w.P(`if err != nil {`)
+	w.serverError("Internal")
+	w.P(`}`)
+	w.P(`resp := &`, w.goIdent(output.GoIdent), `{}`)
+	w.P(`for _, item := range items {`)
+	w.P(`resp.`, fieldName, ` = append(resp.`, fieldName, `, item.ToPB())`)
+	w.P(`}`)
+	if pagination != nil {
+		w.P(`resp.`, pagination.GoName, ` = `, w.paginationValue(pagination, "pagination"))
+	}
+	w.P(`return resp, nil`)
+}
+
+// paginationParam - int32 page or size argument of Paginate, numeric request fields of the other types are converted
+func (w *WormPlugin) paginationParam(method *protogen.Method, input *protogen.Message, field *protogen.Field) string {
+	value := `req.Get` + field.GoName + `()`
+	switch goType := w.goType(field); {
+	case goType == "int32":
+		return value
+	case isNumeric(field) && !strings.HasPrefix(goType, "*"):
+		return `int32(` + value + `)`
+	}
+	w.Fail(fmt.Sprintf("method %s: pagination field %s.%s of type %s is not a number", method.Desc.Name(), input.Desc.Name(), field.Desc.Name(), w.goType(field)))
+	return ""
+}
+
+func (w *WormPlugin) generateServerUpdate(method *protogen.Method, input, output, object *protogen.Message) {
+	if !w.serverModel(method, input, object) {
+		return
+	}
+	if mask := w.findFieldMaskField(input); mask != nil && w.primaryKeyField(object) != nil {
+		w.P(`if _, err := item.UpdateWithMask(ctx, req.Get`, mask.GoName, `()); err != nil {`)
+		w.P(`if errors.Is(err, `, w.updateMaskErrorName(), `) {`)
+		w.serverError("InvalidArgument")
+		w.P(`}`)
+		w.serverError("Internal")
+		w.P(`}`)
+		w.serverResponse(output, object, "item")
+		return
+	}
+	w.P(`if _, err := item.UpdateIfExist(ctx, true); err != nil {`)
+	w.serverError("Internal")
+	w.P(`}`)
+	w.serverResponse(output, object, "item")
+}
+
+func (w *WormPlugin) generateServerDelete(method *protogen.Method, input, output, object *protogen.Message) {
+	id, ok := w.serverId(method, input, object)
+	if !ok {
+		return
+	}
+	pk := w.primaryKeyField(object)
+	w.P(`item := s.store.`, w.messageName(object), `()`)
+	w.P(`item.`, pk.GoName, ` = `, id)
+	w.P(`if err := item.`, w.crudMethodName(object, "Delete"), `(ctx); err != nil {`)
+	w.serverError("Internal")
+	w.P(`}`)
+	w.P(`return &`, w.goIdent(output.GoIdent), `{}, nil`)
+}
@@ -0,0 +1,137 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// operationCases - methods of the UserService of testdata/golden/server.proto, the object type is User,
+// code is a part of the generated method, the methods without the operation are not generated
+var operationCases = []struct {
+	method    string
+	operation string
+	code      string
+}{
+	{method: "CreateUser", operation: operationCreate, code: "item := req.GetUser().ToGorm().SetGorm(s.store.DB())"},
+	{method: "Signup", operation: operationCreate, code: "item := req.ToGorm().SetGorm(s.store.DB())"},
+	{method: "GetUser", operation: operationGet, code: "s.store.User().GetByID(ctx, req.GetId())"},
+	{method: "Lookup", operation: operationGet, code: "return &UserResponse{User: item.ToPB()}, nil"},
+	{method: "ListUsers", operation: operationList, code: "s.store.User().Paginate(ctx, req.GetPage(), req.GetSize())"},
+	{method: "AllUsers", operation: operationList, code: "s.store.User().List(ctx, nil)"},
+	{method: "Users", operation: operationList, code: "s.store.User().List(ctx, nil)"},
+	{method: "UpdateUser", operation: operationUpdate, code: "item.UpdateWithMask(ctx, req.GetUpdateMask())"},
+	{method: "EditUser", operation: operationUpdate, code: "item.UpdateIfExist(ctx, true)"},
+	{method: "DeleteUser", operation: operationDelete, code: "if err := item.Delete(ctx); err != nil {"},
+	{method: "Ping", operation: operationNone},
+	{method: "Health", operation: operationNone},
+}
+
+func TestMethodOperation(t *testing.T) {
+	gen := newGenerator(t, goldenDir, "server", []string{"server"}, "")
+	w := NewWormPlugin()
+	w.Init(gen)
+	file := gen.FilesByPath["server.proto"]
+	user := w.messageByName(file, "User")
+	content := generateFixture(t, goldenDir, "server", "DBDriver=postgres")
+
+	for _, tc := range operationCases {
+		tc := tc
+		t.Run(tc.method, func(t *testing.T) {
+			var found bool
+			for _, method := range file.Services[0].Methods {
+				if method.GoName != tc.method {
+					continue
+				}
+				found = true
+				if op := w.methodOperation(method, method.Input, method.Output, user); op != tc.operation {
+					t.Errorf("operation %q, want %q", op, tc.operation)
+				}
+			}
+			if !found {
+				t.Fatalf("method %s is not found", tc.method)
+			}
+
+			body := serverMethodBody(content, "UserServiceServerWORM", tc.method)
+			if len(tc.code) == 0 && len(body) > 0 {
+				t.Errorf("method is generated, the unimplemented server has to answer:\n%s", body)
+			}
+			if !strings.Contains(body, tc.code) {
+				t.Errorf("generated method does not contain %q:\n%s", tc.code, body)
+			}
+		})
+	}
+}
+
+// serverMethodBody - generated method of the server, empty when it is not generated
+func serverMethodBody(content, server, method string) string {
+	start := strings.Index(content, "func (s *"+server+") "+method+"(")
+	if start < 0 {
+		return ""
+	}
+	end := strings.Index(content[start:], "\n}\n")
+	if end < 0 {
+		return content[start:]
+	}
+	return content[start : start+end+2]
+}
+
+// serverErrors - changes of the testdata/golden fixtures which fail the generation
+var serverErrors = []struct {
+	name    string
+	fixture string
+	change  func(file *descriptorpb.FileDescriptorProto)
+	err     string
+}{
+	{
+		name:    "string id of the integer primary key",
+		fixture: "ids",
+		change: func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[1].Field[0].Type = descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+		},
+		err: "method GetProduct: id field ProductIdRequest.id of type string does not match the primary key Product.id of type int64",
+	},
+	{
+		name:    "bool id of the integer primary key",
+		fixture: "ids",
+		change: func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[2].Field[0].Type = descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum()
+		},
+		err: "method DeleteProduct: id field DeleteProductRequest.productId of type bool does not match the primary key Product.id of type int64",
+	},
+	{
+		name:    "bool page",
+		fixture: "page",
+		change: func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[1].Field[0].Type = descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum()
+		},
+		err: "method ListItems: pagination field ListItemsRequest.page of type bool is not a number",
+	},
+	{
+		name:    "bool pagination field",
+		fixture: "page",
+		change: func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[3].Field[2].Type = descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum()
+		},
+		err: "pagination field Pagination.currentPage of type bool is not a number",
+	},
+}
+
+func TestServerErrors(t *testing.T) {
+	for _, tc := range serverErrors {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			set := readDescriptorSet(t, goldenDir, tc.fixture)
+			for _, file := range set.GetFile() {
+				if file.GetName() == tc.fixture+".proto" {
+					tc.change(file)
+				}
+			}
+			err := NewWormPlugin().Run(generatorOf(t, set, []string{tc.fixture}, "DBDriver=postgres"))
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("error %v, want %q", err, tc.err)
+			}
+		})
+	}
+}
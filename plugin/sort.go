@@ -0,0 +1,150 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/serenize/snaker"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// SortEnum - sort enum bound to the model by the field option, direction is the enum of the sort direction
+type SortEnum struct {
+	field     *protogen.Field
+	enum      *protogen.Enum
+	direction *protogen.Enum
+}
+
+// defaultSortDirection - direction enum of the sort option without the direction
+const defaultSortDirection = "SortTypes"
+
+// unspecifiedSortValue - zero value of the enums by the proto style guide, it is not mapped to a column
+const unspecifiedSortValue = "UNSPECIFIED"
+
+// setSortEnums - collect sort enums bound to the models of the file
+func (w *WormPlugin) setSortEnums(file *protogen.File) {
+	for _, msg := range fileMessages(file) {
+		for _, field := range msg.Fields {
+			opts := w.getFieldOptions(field)
+			if opts == nil || opts.Sort == nil || len(opts.Sort.GetModel()) == 0 {
+				continue
+			}
+			if !isEnum(field) {
+				w.Fail(fmt.Sprintf("field %s.%s: sort option requires an enum field", msg.Desc.Name(), field.Desc.Name()))
+				return
+			}
+			enum := field.Enum
+			model := w.messageByName(file, opts.Sort.GetModel())
+			if model == nil {
+				w.Fail(fmt.Sprintf("field %s.%s: sort model %s not found", msg.Desc.Name(), field.Desc.Name(), opts.Sort.GetModel()))
+				return
+			}
+			if msgOpts, ok := w.getMessageOptions(model); !ok || !msgOpts.GetModel() {
+				w.Fail(fmt.Sprintf("field %s.%s: sort model %s is not a model", msg.Desc.Name(), field.Desc.Name(), opts.Sort.GetModel()))
+				return
+			}
+			directionName := opts.Sort.GetDirection()
+			if len(directionName) == 0 {
+				directionName = defaultSortDirection
+			}
+			direction := w.enumByName(file, directionName)
+			if direction == nil {
+				w.Fail(fmt.Sprintf("field %s.%s: sort direction enum %s not found", msg.Desc.Name(), field.Desc.Name(), directionName))
+				return
+			}
+			name := w.generateModelName(opts.Sort.GetModel())
+			if val, ok := w.SortEnums[name]; ok && val.enum != enum {
+				w.Fail(fmt.Sprintf("model %s: more than one sort enum is bound", opts.Sort.GetModel()))
+				return
+			}
+			w.SortEnums[name] = SortEnum{field: field, enum: enum, direction: direction}
+		}
+	}
+}
+
+// enumByName - enum of the file by its proto name, nested enums are named with the parents (User.Status)
+func (w *WormPlugin) enumByName(file *protogen.File, name string) *protogen.Enum {
+	enums := file.Enums
+	for _, msg := range fileMessages(file) {
+		enums = append(enums, msg.Enums...)
+	}
+	for _, enum := range enums {
+		if strings.TrimPrefix(string(enum.Desc.FullName()), string(file.Desc.Package())+".") == name {
+			return enum
+		}
+	}
+	return nil
+}
+
+// sortValueName - name of the enum value without the enum type prefix (SORT_FIELD_CREATED_AT -> CREATED_AT)
+func sortValueName(enum *protogen.Enum, value *protogen.EnumValue) string {
+	prefix := strings.ToUpper(snaker.CamelToSnake(string(enum.Desc.Name()))) + "_"
+	return strings.TrimPrefix(string(value.Desc.Name()), prefix)
+}
+
+// sameSortName - case insensitive comparison without the underscores (CREATED_AT, created_at and createdAt are the same)
+func sameSortName(a, b string) bool {
+	return strings.EqualFold(strings.Replace(a, "_", "", -1), strings.Replace(b, "_", "", -1))
+}
+
+// sortColumnField - model field of the sort enum value
+func (w *WormPlugin) sortColumnField(message *protogen.Message, name string) *protogen.Field {
+	for _, field := range message.Fields {
+		if w.isColumnField(field) && sameSortName(string(field.Desc.Name()), name) {
+			return field
+		}
+	}
+	return nil
+}
+
+// generateSortMethod - ApplySort maps sort enum values to the model columns
+func (w *WormPlugin) generateSortMethod(message *protogen.Message) {
+	mName := w.modelName(message)
+	sort, ok := w.SortEnums[mName]
+	if !ok {
+		return
+	}
+	enumType := w.goIdent(sort.enum.GoIdent)
+	directionType := w.goIdent(sort.direction.GoIdent)
+
+	w.P(`// ApplySort - order query of `, mName, ` by `, enumType, ` value and `, directionType, ` direction`)
+	w.P(`func (e *`, mName, `) ApplySort(query *gorm.DB, field `, enumType, `, dir `, directionType, `) (*gorm.DB, error) {`)
+	w.P(`var column string`)
+	w.P(`switch field {`)
+	for _, value := range sort.enum.Values {
+		name := sortValueName(sort.enum, value)
+		// the unspecified value is rejected as unknown
+		if name == unspecifiedSortValue {
+			continue
+		}
+		column := w.sortColumnField(message, name)
+		if column == nil {
+			w.Fail(fmt.Sprintf("sort enum %s: value %s matches no column of %s", sort.enum.Desc.Name(), value.Desc.Name(), message.Desc.Name()))
+			return
+		}
+		w.P(`case `, w.goIdent(value.GoIdent), `:`)
+		w.P(`column = "`, w.columnName(column), `"`)
+	}
+	w.P(`default:`)
+	w.P(`return query, fmt.Errorf("unknown sort field %v", field)`)
+	w.P(`}`)
+	w.P(`switch dir {`)
+	for _, value := range sort.direction.Values {
+		// the unspecified direction is ascending
+		switch name := sortValueName(sort.direction, value); {
+		case strings.EqualFold(name, "asc"), name == unspecifiedSortValue:
+			w.P(`case `, w.goIdent(value.GoIdent), `:`)
+			w.P(`return query.Order(column + " asc"), nil`)
+		case strings.EqualFold(name, "desc"):
+			w.P(`case `, w.goIdent(value.GoIdent), `:`)
+			w.P(`return query.Order(column + " desc"), nil`)
+		default:
+			w.Fail(fmt.Sprintf("sort direction enum %s: value %s is neither ASC nor DESC", sort.direction.Desc.Name(), value.Desc.Name()))
+			return
+		}
+	}
+	w.P(`}`)
+	w.P(`return query, fmt.Errorf("unknown sort direction %v", dir)`)
+	w.P(`}`)
+	w.P()
+}
@@ -0,0 +1,57 @@
+package plugin
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// sortErrors - changes of testdata/golden/sort.proto which fail the generation
+var sortErrors = []struct {
+	name   string
+	change func(file *descriptorpb.FileDescriptorProto)
+	err    string
+}{
+	{
+		name: "unknown sort value",
+		change: func(file *descriptorpb.FileDescriptorProto) {
+			file.MessageType[1].EnumType[0].Value[2].Name = proto.String("SORT_RATING")
+		},
+		err: "sort enum Sort: value SORT_RATING matches no column of Article",
+	},
+	{
+		name: "unknown direction value",
+		change: func(file *descriptorpb.FileDescriptorProto) {
+			file.EnumType[0].Value[2].Name = proto.String("SORT_TYPES_RANDOM")
+		},
+		err: "sort direction enum SortTypes: value SORT_TYPES_RANDOM is neither ASC nor DESC",
+	},
+	{
+		name: "missing direction enum",
+		change: func(file *descriptorpb.FileDescriptorProto) {
+			file.EnumType[0].Name = proto.String("Order")
+			file.MessageType[1].Field[1].TypeName = proto.String(".golden.Order")
+		},
+		err: "field ArticleListSortFields.sort: sort direction enum SortTypes not found",
+	},
+}
+
+func TestSortErrors(t *testing.T) {
+	for _, tc := range sortErrors {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			set := readDescriptorSet(t, goldenDir, "sort")
+			for _, file := range set.GetFile() {
+				if file.GetName() == "sort.proto" {
+					tc.change(file)
+				}
+			}
+			err := NewWormPlugin().Run(generatorOf(t, set, []string{"sort"}, "DBDriver=postgres"))
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("error %v, want %q", err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package plugin
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// sqliteDir - fixture of the integration tests, store.proto is compiled into store.desc and store.pb.go (make golden),
+// store_test.go runs the generated code against an in-memory sqlite database
+const sqliteDir = "testdata/sqlite"
+
+// sqliteModule - go.mod of the generated code, the plugin module provides the versions of the dependencies
+const sqliteModule = `module store
+
+go 1.23
+
+require (
+	github.com/cjp2600/protoc-gen-worm v0.0.0-00010101000000-000000000000
+	google.golang.org/grpc v1.36.0
+	gorm.io/driver/sqlite v1.0.8
+)
+
+replace github.com/cjp2600/protoc-gen-worm => %s
+`
+
+func TestSQLite(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiles the generated code with the cgo sqlite driver")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command is not found")
+	}
+	root, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "worm-sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string][]byte{
+		"go.mod":           []byte(fmt.Sprintf(sqliteModule, root)),
+		"store.pb.worm.go": []byte(generateFixture(t, sqliteDir, "store", "DBDriver=sqlite")),
+	}
+	for dst, src := range map[string]string{
+		"go.sum":        filepath.Join(root, "go.sum"),
+		"store.pb.go":   filepath.Join(sqliteDir, "store.pb.go"),
+		"store_test.go": filepath.Join(sqliteDir, "store_test.go"),
+	} {
+		data, err := ioutil.ReadFile(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[dst] = data
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cmd := exec.Command(goBin, "test", "-count=1", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "CGO_ENABLED=1", "GOFLAGS=-mod=mod")
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("generated code tests: %v\n%s", err, out)
+	}
+}
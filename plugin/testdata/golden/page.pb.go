@@ -0,0 +1,334 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: page.proto
+
+package golden
+
+import (
+	_ "github.com/cjp2600/protoc-gen-worm/plugin/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Item struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Item) Reset() {
+	*x = Item{}
+	mi := &file_page_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Item) ProtoMessage() {}
+
+func (x *Item) ProtoReflect() protoreflect.Message {
+	mi := &file_page_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Item.ProtoReflect.Descriptor instead.
+func (*Item) Descriptor() ([]byte, []int) {
+	return file_page_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Item) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Item) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int64                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          uint32                 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsRequest) Reset() {
+	*x = ListItemsRequest{}
+	mi := &file_page_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsRequest) ProtoMessage() {}
+
+func (x *ListItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_page_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListItemsRequest) Descriptor() ([]byte, []int) {
+	return file_page_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListItemsRequest) GetPage() int64 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListItemsRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Item                `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemsResponse) Reset() {
+	*x = ListItemsResponse{}
+	mi := &file_page_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsResponse) ProtoMessage() {}
+
+func (x *ListItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_page_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListItemsResponse) Descriptor() ([]byte, []int) {
+	return file_page_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListItemsResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListItemsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalCount    int64                  `protobuf:"varint,1,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	TotalPages    int64                  `protobuf:"varint,2,opt,name=totalPages,proto3" json:"totalPages,omitempty"`
+	CurrentPage   uint32                 `protobuf:"varint,3,opt,name=currentPage,proto3" json:"currentPage,omitempty"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_page_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_page_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_page_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Pagination) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotalPages() int64 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *Pagination) GetCurrentPage() uint32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+var File_page_proto protoreflect.FileDescriptor
+
+const file_page_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"page.proto\x12\x06golden\x1a\x19plugin/options/worm.proto\"K\n" +
+	"\x04Item\x12$\n" +
+	"\x02id\x18\x01 \x01(\tB\x14\x9a\xa4\xa2\x01\x0f\n" +
+	"\r\x1a\vprimary_keyR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name:\t\x9a\xa4\xa2\x01\x04\b\x01\x18\x01\":\n" +
+	"\x10ListItemsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x03R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\rR\x04size\"k\n" +
+	"\x11ListItemsResponse\x12\"\n" +
+	"\x05items\x18\x01 \x03(\v2\f.golden.ItemR\x05items\x122\n" +
+	"\n" +
+	"pagination\x18\x02 \x01(\v2\x12.golden.PaginationR\n" +
+	"pagination\"\x82\x01\n" +
+	"\n" +
+	"Pagination\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x01 \x01(\x03R\n" +
+	"totalCount\x12\x1e\n" +
+	"\n" +
+	"totalPages\x18\x02 \x01(\x03R\n" +
+	"totalPages\x12 \n" +
+	"\vcurrentPage\x18\x03 \x01(\rR\vcurrentPage\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size2e\n" +
+	"\vItemService\x12M\n" +
+	"\tListItems\x12\x18.golden.ListItemsRequest\x1a\x19.golden.ListItemsResponse\"\v\x9a\xa4\xa2\x01\x06\n" +
+	"\x04Item\x1a\a\x9a\xa4\xa2\x01\x02\b\x01BBZ@github.com/cjp2600/protoc-gen-worm/plugin/testdata/golden;goldenb\x06proto3"
+
+var (
+	file_page_proto_rawDescOnce sync.Once
+	file_page_proto_rawDescData []byte
+)
+
+func file_page_proto_rawDescGZIP() []byte {
+	file_page_proto_rawDescOnce.Do(func() {
+		file_page_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_page_proto_rawDesc), len(file_page_proto_rawDesc)))
+	})
+	return file_page_proto_rawDescData
+}
+
+var file_page_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_page_proto_goTypes = []any{
+	(*Item)(nil),              // 0: golden.Item
+	(*ListItemsRequest)(nil),  // 1: golden.ListItemsRequest
+	(*ListItemsResponse)(nil), // 2: golden.ListItemsResponse
+	(*Pagination)(nil),        // 3: golden.Pagination
+}
+var file_page_proto_depIdxs = []int32{
+	0, // 0: golden.ListItemsResponse.items:type_name -> golden.Item
+	3, // 1: golden.ListItemsResponse.pagination:type_name -> golden.Pagination
+	1, // 2: golden.ItemService.ListItems:input_type -> golden.ListItemsRequest
+	2, // 3: golden.ItemService.ListItems:output_type -> golden.ListItemsResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_page_proto_init() }
+func file_page_proto_init() {
+	if File_page_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_page_proto_rawDesc), len(file_page_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_page_proto_goTypes,
+		DependencyIndexes: file_page_proto_depIdxs,
+		MessageInfos:      file_page_proto_msgTypes,
+	}.Build()
+	File_page_proto = out.File
+	file_page_proto_goTypes = nil
+	file_page_proto_depIdxs = nil
+}
@@ -0,0 +1,1029 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: store.proto
+
+package store
+
+import (
+	_ "github.com/cjp2600/protoc-gen-worm/plugin/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UserIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserIdRequest) Reset() {
+	*x = UserIdRequest{}
+	mi := &file_store_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIdRequest) ProtoMessage() {}
+
+func (x *UserIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIdRequest.ProtoReflect.Descriptor instead.
+func (*UserIdRequest) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UserIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PrivateUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivateUser) Reset() {
+	*x = PrivateUser{}
+	mi := &file_store_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivateUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivateUser) ProtoMessage() {}
+
+func (x *PrivateUser) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivateUser.ProtoReflect.Descriptor instead.
+func (*PrivateUser) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PrivateUser) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// user model merged with the private fields
+type User struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name   string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Active bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	Score  int64                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	// Types that are valid to be assigned to Contact:
+	//
+	//	*User_Phone
+	//	*User_Telegram
+	Contact       isUser_Contact         `protobuf_oneof:"contact"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Address       *Address               `protobuf:"bytes,9,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_store_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *User) GetScore() int64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *User) GetContact() isUser_Contact {
+	if x != nil {
+		return x.Contact
+	}
+	return nil
+}
+
+func (x *User) GetPhone() string {
+	if x != nil {
+		if x, ok := x.Contact.(*User_Phone); ok {
+			return x.Phone
+		}
+	}
+	return ""
+}
+
+func (x *User) GetTelegram() string {
+	if x != nil {
+		if x, ok := x.Contact.(*User_Telegram); ok {
+			return x.Telegram
+		}
+	}
+	return ""
+}
+
+func (x *User) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *User) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type isUser_Contact interface {
+	isUser_Contact()
+}
+
+type User_Phone struct {
+	Phone string `protobuf:"bytes,6,opt,name=phone,proto3,oneof"`
+}
+
+type User_Telegram struct {
+	Telegram string `protobuf:"bytes,7,opt,name=telegram,proto3,oneof"`
+}
+
+func (*User_Phone) isUser_Contact() {}
+
+func (*User_Telegram) isUser_Contact() {}
+
+type Address struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_store_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+// invite sent to an address or shared as a code, the address member is stored as json
+type Invite struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*Invite_Address
+	//	*Invite_Code
+	Target        isInvite_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_store_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Invite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invite) GetTarget() isInvite_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Invite) GetAddress() *Address {
+	if x != nil {
+		if x, ok := x.Target.(*Invite_Address); ok {
+			return x.Address
+		}
+	}
+	return nil
+}
+
+func (x *Invite) GetCode() string {
+	if x != nil {
+		if x, ok := x.Target.(*Invite_Code); ok {
+			return x.Code
+		}
+	}
+	return ""
+}
+
+type isInvite_Target interface {
+	isInvite_Target()
+}
+
+type Invite_Address struct {
+	Address *Address `protobuf:"bytes,2,opt,name=address,proto3,oneof"`
+}
+
+type Invite_Code struct {
+	Code string `protobuf:"bytes,3,opt,name=code,proto3,oneof"`
+}
+
+func (*Invite_Address) isInvite_Target() {}
+
+func (*Invite_Code) isInvite_Target() {}
+
+// soft deleted note, deleted notes are not counted
+type Note struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Note) Reset() {
+	*x = Note{}
+	mi := &file_store_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Note) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Note) ProtoMessage() {}
+
+func (x *Note) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Note.ProtoReflect.Descriptor instead.
+func (*Note) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Note) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Note) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// session with the optional time to live and expiration time
+type Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ttl           *durationpb.Duration   `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_store_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Session) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// counter with the integer primary key assigned by the database
+type Counter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         int64                  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Counter) Reset() {
+	*x = Counter{}
+	mi := &file_store_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Counter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counter) ProtoMessage() {}
+
+func (x *Counter) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counter.ProtoReflect.Descriptor instead.
+func (*Counter) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Counter) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Counter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Counter) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// setting stored as json, messages which can not be converted fail the queries
+type Setting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Data          *structpb.Struct       `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Payload       *anypb.Any             `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Setting) Reset() {
+	*x = Setting{}
+	mi := &file_store_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Setting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Setting) ProtoMessage() {}
+
+func (x *Setting) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Setting.ProtoReflect.Descriptor instead.
+func (*Setting) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Setting) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Setting) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Setting) GetPayload() *anypb.Any {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// team with the nested member model, the lead member is stored as json
+type Team struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Leader:
+	//
+	//	*Team_Lead
+	//	*Team_Vacancy
+	Leader        isTeam_Leader `protobuf_oneof:"leader"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_store_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Team) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Team) GetLeader() isTeam_Leader {
+	if x != nil {
+		return x.Leader
+	}
+	return nil
+}
+
+func (x *Team) GetLead() *Team_Member {
+	if x != nil {
+		if x, ok := x.Leader.(*Team_Lead); ok {
+			return x.Lead
+		}
+	}
+	return nil
+}
+
+func (x *Team) GetVacancy() string {
+	if x != nil {
+		if x, ok := x.Leader.(*Team_Vacancy); ok {
+			return x.Vacancy
+		}
+	}
+	return ""
+}
+
+type isTeam_Leader interface {
+	isTeam_Leader()
+}
+
+type Team_Lead struct {
+	Lead *Team_Member `protobuf:"bytes,2,opt,name=lead,proto3,oneof"`
+}
+
+type Team_Vacancy struct {
+	Vacancy string `protobuf:"bytes,3,opt,name=vacancy,proto3,oneof"`
+}
+
+func (*Team_Lead) isTeam_Leader() {}
+
+func (*Team_Vacancy) isTeam_Leader() {}
+
+// registration request converted to the user model
+type Registration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Types that are valid to be assigned to NameField:
+	//
+	//	*Registration_Name
+	NameField     isRegistration_NameField `protobuf_oneof:"nameField"`
+	Password      string                   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Registration) Reset() {
+	*x = Registration{}
+	mi := &file_store_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Registration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Registration) ProtoMessage() {}
+
+func (x *Registration) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Registration.ProtoReflect.Descriptor instead.
+func (*Registration) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Registration) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Registration) GetNameField() isRegistration_NameField {
+	if x != nil {
+		return x.NameField
+	}
+	return nil
+}
+
+func (x *Registration) GetName() string {
+	if x != nil {
+		if x, ok := x.NameField.(*Registration_Name); ok {
+			return x.Name
+		}
+	}
+	return ""
+}
+
+func (x *Registration) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type isRegistration_NameField interface {
+	isRegistration_NameField()
+}
+
+type Registration_Name struct {
+	Name string `protobuf:"bytes,2,opt,name=name,proto3,oneof"`
+}
+
+func (*Registration_Name) isRegistration_NameField() {}
+
+type Team_Member struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team_Member) Reset() {
+	*x = Team_Member{}
+	mi := &file_store_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team_Member) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team_Member) ProtoMessage() {}
+
+func (x *Team_Member) ProtoReflect() protoreflect.Message {
+	mi := &file_store_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team_Member.ProtoReflect.Descriptor instead.
+func (*Team_Member) Descriptor() ([]byte, []int) {
+	return file_store_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *Team_Member) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Team_Member) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_store_proto protoreflect.FileDescriptor
+
+const file_store_proto_rawDesc = "" +
+	"\n" +
+	"\vstore.proto\x12\x05store\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19plugin/options/worm.proto\"\x1f\n" +
+	"\rUserIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\vPrivateUser\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\"\xa6\x03\n" +
+	"\x04User\x12$\n" +
+	"\x02id\x18\x01 \x01(\tB\x14\x9a\xa4\xa2\x01\x0f\n" +
+	"\r\x1a\vprimary_keyR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06active\x18\x04 \x01(\bR\x06active\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x03R\x05score\x12\x16\n" +
+	"\x05phone\x18\x06 \x01(\tH\x00R\x05phone\x12\x1c\n" +
+	"\btelegram\x18\a \x01(\tH\x00R\btelegram\x12\x1d\n" +
+	"\x04tags\x18\b \x03(\tB\t\x9a\xa4\xa2\x01\x04\n" +
+	"\x02(\x01R\x04tags\x124\n" +
+	"\aaddress\x18\t \x01(\v2\x0e.store.AddressB\n" +
+	"\x9a\xa4\xa2\x01\x05\n" +
+	"\x03\x1a\x01-R\aaddress\x128\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\tupdatedAt\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt:\x16\x9a\xa4\xa2\x01\x11\b\x01\x18\x01\"\vPrivateUserB\t\n" +
+	"\acontact\"&\n" +
+	"\aAddress\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city:\a\x9a\xa4\xa2\x01\x02\b\x01\"\x85\x01\n" +
+	"\x06Invite\x12$\n" +
+	"\x02id\x18\x01 \x01(\tB\x14\x9a\xa4\xa2\x01\x0f\n" +
+	"\r\x1a\vprimary_keyR\x02id\x12*\n" +
+	"\aaddress\x18\x02 \x01(\v2\x0e.store.AddressH\x00R\aaddress\x12\x14\n" +
+	"\x04code\x18\x03 \x01(\tH\x00R\x04code:\t\x9a\xa4\xa2\x01\x04\b\x01\x18\x01B\b\n" +
+	"\x06target\"M\n" +
+	"\x04Note\x12$\n" +
+	"\x02id\x18\x01 \x01(\tB\x14\x9a\xa4\xa2\x01\x0f\n" +
+	"\r\x1a\vprimary_keyR\x02id\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text:\v\x9a\xa4\xa2\x01\x06\b\x01\x18\x010\x01\"\xa1\x01\n" +
+	"\aSession\x12$\n" +
+	"\x02id\x18\x01 \x01(\tB\x14\x9a\xa4\xa2\x01\x0f\n" +
+	"\r\x1a\vprimary_keyR\x02id\x12+\n" +
+	"\x03ttl\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x03ttl\x128\n" +
+	"\texpiresAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt:\t\x9a\xa4\xa2\x01\x04\b\x01\x18\x01\"d\n" +
+	"\aCounter\x12$\n" +
+	"\x02id\x18\x01 \x01(\x03B\x14\x9a\xa4\xa2\x01\x0f\n" +
+	"\r\x1a\vprimary_keyR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x03R\x05value:\t\x9a\xa4\xa2\x01\x04\b\x01\x18\x01\"\x97\x01\n" +
+	"\aSetting\x12$\n" +
+	"\x02id\x18\x01 \x01(\tB\x14\x9a\xa4\xa2\x01\x0f\n" +
+	"\r\x1a\vprimary_keyR\x02id\x12+\n" +
+	"\x04data\x18\x02 \x01(\v2\x17.google.protobuf.StructR\x04data\x12.\n" +
+	"\apayload\x18\x03 \x01(\v2\x14.google.protobuf.AnyR\apayload:\t\x9a\xa4\xa2\x01\x04\b\x01\x18\x01\"\xd6\x01\n" +
+	"\x04Team\x12$\n" +
+	"\x02id\x18\x01 \x01(\tB\x14\x9a\xa4\xa2\x01\x0f\n" +
+	"\r\x1a\vprimary_keyR\x02id\x12(\n" +
+	"\x04lead\x18\x02 \x01(\v2\x12.store.Team.MemberH\x00R\x04lead\x12\x1a\n" +
+	"\avacancy\x18\x03 \x01(\tH\x00R\avacancy\x1aM\n" +
+	"\x06Member\x12$\n" +
+	"\x02id\x18\x01 \x01(\tB\x14\x9a\xa4\xa2\x01\x0f\n" +
+	"\r\x1a\vprimary_keyR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name:\t\x9a\xa4\xa2\x01\x04\b\x01\x18\x01:\t\x9a\xa4\xa2\x01\x04\b\x01\x18\x01B\b\n" +
+	"\x06leader\"r\n" +
+	"\fRegistration\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x14\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword:\r\x9a\xa4\xa2\x01\b\b\x01*\x04UserB\v\n" +
+	"\tnameField2K\n" +
+	"\x05Store\x129\n" +
+	"\aGetUser\x12\x14.store.UserIdRequest\x1a\v.store.User\"\v\x9a\xa4\xa2\x01\x06\n" +
+	"\x04User\x1a\a\x9a\xa4\xa2\x01\x02\x10\x01BAZ?github.com/cjp2600/protoc-gen-worm/plugin/testdata/sqlite;storeb\x06proto3"
+
+var (
+	file_store_proto_rawDescOnce sync.Once
+	file_store_proto_rawDescData []byte
+)
+
+func file_store_proto_rawDescGZIP() []byte {
+	file_store_proto_rawDescOnce.Do(func() {
+		file_store_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_store_proto_rawDesc), len(file_store_proto_rawDesc)))
+	})
+	return file_store_proto_rawDescData
+}
+
+var file_store_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_store_proto_goTypes = []any{
+	(*UserIdRequest)(nil),         // 0: store.UserIdRequest
+	(*PrivateUser)(nil),           // 1: store.PrivateUser
+	(*User)(nil),                  // 2: store.User
+	(*Address)(nil),               // 3: store.Address
+	(*Invite)(nil),                // 4: store.Invite
+	(*Note)(nil),                  // 5: store.Note
+	(*Session)(nil),               // 6: store.Session
+	(*Counter)(nil),               // 7: store.Counter
+	(*Setting)(nil),               // 8: store.Setting
+	(*Team)(nil),                  // 9: store.Team
+	(*Registration)(nil),          // 10: store.Registration
+	(*Team_Member)(nil),           // 11: store.Team.Member
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 13: google.protobuf.Duration
+	(*structpb.Struct)(nil),       // 14: google.protobuf.Struct
+	(*anypb.Any)(nil),             // 15: google.protobuf.Any
+}
+var file_store_proto_depIdxs = []int32{
+	3,  // 0: store.User.address:type_name -> store.Address
+	12, // 1: store.User.createdAt:type_name -> google.protobuf.Timestamp
+	12, // 2: store.User.updatedAt:type_name -> google.protobuf.Timestamp
+	3,  // 3: store.Invite.address:type_name -> store.Address
+	13, // 4: store.Session.ttl:type_name -> google.protobuf.Duration
+	12, // 5: store.Session.expiresAt:type_name -> google.protobuf.Timestamp
+	14, // 6: store.Setting.data:type_name -> google.protobuf.Struct
+	15, // 7: store.Setting.payload:type_name -> google.protobuf.Any
+	11, // 8: store.Team.lead:type_name -> store.Team.Member
+	0,  // 9: store.Store.GetUser:input_type -> store.UserIdRequest
+	2,  // 10: store.Store.GetUser:output_type -> store.User
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_store_proto_init() }
+func file_store_proto_init() {
+	if File_store_proto != nil {
+		return
+	}
+	file_store_proto_msgTypes[2].OneofWrappers = []any{
+		(*User_Phone)(nil),
+		(*User_Telegram)(nil),
+	}
+	file_store_proto_msgTypes[4].OneofWrappers = []any{
+		(*Invite_Address)(nil),
+		(*Invite_Code)(nil),
+	}
+	file_store_proto_msgTypes[9].OneofWrappers = []any{
+		(*Team_Lead)(nil),
+		(*Team_Vacancy)(nil),
+	}
+	file_store_proto_msgTypes[10].OneofWrappers = []any{
+		(*Registration_Name)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_store_proto_rawDesc), len(file_store_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_store_proto_goTypes,
+		DependencyIndexes: file_store_proto_depIdxs,
+		MessageInfos:      file_store_proto_msgTypes,
+	}.Build()
+	File_store_proto = out.File
+	file_store_proto_goTypes = nil
+	file_store_proto_depIdxs = nil
+}
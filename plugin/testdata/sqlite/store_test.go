@@ -0,0 +1,538 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// newStore - data store of a private in-memory database, one connection keeps the database alive
+func newStore(t *testing.T) *StoreDataStore {
+	t.Helper()
+	store, err := NewStoreDataStoreWithConfig(StoreDataStoreConfig{DSN: ":memory:", AutoMigrate: true},
+		StoreWithPool(1, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func newUser(id string) *User {
+	created := timestamppb.New(time.Date(2020, 9, 1, 10, 0, 0, 0, time.UTC))
+	return &User{
+		Id:        id,
+		Email:     id + "@example.com",
+		Name:      "name " + id,
+		Active:    true,
+		Score:     10,
+		Contact:   &User_Phone{Phone: "+100"},
+		Tags:      []string{"a", "b"},
+		Address:   &Address{City: "Berlin"},
+		CreatedAt: created,
+		UpdatedAt: created,
+	}
+}
+
+func TestCRUD(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+
+	for _, id := range []string{"u1", "u2", "u3"} {
+		if _, err := newUser(id).ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := store.User().GetByID(ctx, "u2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Email != "u2@example.com" || got.GetPhone() != "+100" {
+		t.Errorf("GetByID = %+v", got)
+	}
+
+	count, err := store.User().Count(ctx)
+	if err != nil || count != 3 {
+		t.Errorf("Count = %d, %v, want 3", count, err)
+	}
+
+	items, err := store.User().List(ctx, &StoreListOptions{Where: map[string]interface{}{"active": true}, Order: "id desc", Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || items[0].Id != "u3" || items[1].Id != "u2" {
+		t.Errorf("List = %d items", len(items))
+	}
+
+	if err := got.Delete(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.User().GetByID(ctx, "u2"); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("GetByID of the deleted record: %v, want record not found", err)
+	}
+	if count, _ := store.User().Count(ctx); count != 2 {
+		t.Errorf("Count after Delete = %d, want 2", count)
+	}
+}
+
+func TestUpdateIfExist(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+	if _, err := newUser("u1").ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// zero values are not updated, the set oneof member clears the other ones
+	telegram := "@u1"
+	update := store.User()
+	update.Id = "u1"
+	update.Name = "renamed"
+	update.Telegram = &telegram
+	if _, err := update.UpdateIfExist(ctx, true); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := store.User().GetByID(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "renamed" {
+		t.Errorf("Name = %q, want renamed", got.Name)
+	}
+	if got.Email != "u1@example.com" || !got.Active || got.Score != 10 {
+		t.Errorf("zero values of the update changed the record: %+v", got)
+	}
+	if got.Phone != nil || got.GetTelegram() != "@u1" {
+		t.Errorf("oneof contact = %v, %v, want telegram only", got.Phone, got.Telegram)
+	}
+	if got.UpdatedAt == nil || got.CreatedAt == nil || !got.UpdatedAt.After(*got.CreatedAt) {
+		t.Errorf("UpdatedAt %v is not after CreatedAt %v", got.UpdatedAt, got.CreatedAt)
+	}
+}
+
+func TestUpdateIfExistIntegerKey(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+	created, err := (&Counter{Name: "visits", Value: 1}).ToGorm().SetGorm(store.DB()).Create(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Id == 0 {
+		t.Fatal("primary key is expected to be assigned by the database")
+	}
+	if _, err := (&Counter{Name: "other", Value: 1}).ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// the update is bound to the record of the primary key
+	update := store.Counter()
+	update.Id = created.Id
+	update.Value = 5
+	if _, err := update.UpdateIfExist(ctx, false); err != nil {
+		t.Fatal(err)
+	}
+	items, err := store.Counter().List(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range items {
+		if want := map[bool]int64{true: 5, false: 1}[item.Id == created.Id]; item.Value != want {
+			t.Errorf("counter %d value = %d, want %d", item.Id, item.Value, want)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+	user := newUser("u1")
+
+	if got := user.ToGorm().ToPB(); !proto.Equal(got, user) {
+		t.Errorf("ToGorm().ToPB() = %v, want %v", got, user)
+	}
+
+	if _, err := user.ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.User().GetByID(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// address is not a column
+	want := proto.Clone(user).(*User)
+	want.Address = nil
+	if pb := got.ToPB(); !proto.Equal(pb, want) {
+		t.Errorf("stored ToPB() = %v, want %v", pb, want)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+
+	user := newUser("u1").ToGorm().MergePrivateUserWORM(&PrivateUserWORM{Password: "secret"})
+	if _, err := user.SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.User().GetByID(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Password != "secret" {
+		t.Errorf("merged Password = %q, want secret", got.Password)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+
+	registration := &Registration{Email: "new@example.com", NameField: &Registration_Name{Name: "new"}, Password: "secret"}
+	user := registration.ToGorm().ToUserWORM()
+	if user.Email != "new@example.com" || user.Name != "new" || user.Password != "secret" {
+		t.Fatalf("ToUserWORM = %+v", user)
+	}
+
+	user.Id = "u1"
+	if _, err := user.SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.User().GetByID(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Email != "new@example.com" || got.Name != "new" || got.Password != "secret" {
+		t.Errorf("stored converted user = %+v", got)
+	}
+}
+
+func TestOneOfMessage(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+
+	invite := &Invite{Id: "i1", Target: &Invite_Address{Address: &Address{City: "Berlin"}}}
+	if got := invite.ToGorm().ToPB(); !proto.Equal(got, invite) {
+		t.Errorf("ToGorm().ToPB() = %v, want %v", got, invite)
+	}
+	if _, err := invite.ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// the address member is stored as json
+	got, err := store.Invite().GetByID(ctx, "i1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb := got.ToPB(); !proto.Equal(pb, invite) {
+		t.Errorf("stored ToPB() = %v, want %v", pb, invite)
+	}
+
+	code := &Invite{Id: "i2", Target: &Invite_Code{Code: "X1"}}
+	if _, err := code.ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	got, err = store.Invite().GetByID(ctx, "i2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb := got.ToPB(); !proto.Equal(pb, code) {
+		t.Errorf("stored ToPB() = %v, want %v", pb, code)
+	}
+}
+
+func TestNestedMessage(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+
+	member := &Team_Member{Id: "m1", Name: "Ann"}
+	if _, err := member.ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	gotMember, err := store.Team_Member().GetByID(ctx, "m1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb := gotMember.ToPB(); !proto.Equal(pb, member) {
+		t.Errorf("stored member ToPB() = %v, want %v", pb, member)
+	}
+
+	team := &Team{Id: "t1", Leader: &Team_Lead{Lead: member}}
+	if _, err := team.ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	gotTeam, err := store.Team().GetByID(ctx, "t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb := gotTeam.ToPB(); !proto.Equal(pb, team) {
+		t.Errorf("stored team ToPB() = %v, want %v", pb, team)
+	}
+}
+
+func TestSoftDelete(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+	for _, id := range []string{"n1", "n2", "n3"} {
+		if _, err := (&Note{Id: id, Text: "note " + id}).ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	note, err := store.Note().GetByID(ctx, "n2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := note.Delete(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if count, err := store.Note().Count(ctx); err != nil || count != 2 {
+		t.Errorf("Count = %d, %v, want 2", count, err)
+	}
+	items, pagination, err := store.Note().Paginate(ctx, 1, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || pagination.GetTotalCount() != 2 || pagination.GetTotalPages() != 1 {
+		t.Errorf("Paginate = %d items, %v, want 2 items of 2", len(items), pagination)
+	}
+	var stored int64
+	if err := store.DB().Unscoped().Table(note.TableName()).Count(&stored).Error; err != nil || stored != 3 {
+		t.Errorf("stored records = %d, %v, want 3 with the soft deleted one", stored, err)
+	}
+}
+
+func TestNilWellKnown(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+	sessions := []*Session{
+		{Id: "s1"},
+		{Id: "s2", Ttl: durationpb.New(0)},
+		{Id: "s3", Ttl: durationpb.New(time.Hour)},
+		{Id: "s4", ExpiresAt: timestamppb.New(time.Date(2020, 9, 1, 10, 0, 0, 0, time.UTC))},
+		// zero time is a set timestamp and is kept apart from the unset one
+		{Id: "s5", ExpiresAt: timestamppb.New(time.Time{})},
+	}
+	for _, session := range sessions {
+		if got := session.ToGorm().ToPB(); !proto.Equal(got, session) {
+			t.Errorf("ToGorm().ToPB() = %v, want %v", got, session)
+		}
+		if _, err := session.ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+			t.Fatal(err)
+		}
+		got, err := store.Session().GetByID(ctx, session.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pb := got.ToPB(); !proto.Equal(pb, session) {
+			t.Errorf("stored ToPB() = %v, want %v", pb, session)
+		}
+	}
+	var unset int64
+	if err := store.DB().Table(NewSessionWORM().TableName()).Where("expires_at IS NULL").Count(&unset).Error; err != nil || unset != 3 {
+		t.Errorf("NULL expiration times = %d, %v, want 3", unset, err)
+	}
+}
+
+func TestUpdateWithMask(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+	if _, err := newUser("u1").ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// zero values of the mask paths clear the columns, the other columns are kept
+	update := &User{Id: "u1", Email: "changed@example.com"}
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"name", "score", "active"}}
+	if _, err := update.ToGorm().SetGorm(store.DB()).UpdateWithMask(ctx, mask); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.User().GetByID(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "" || got.Score != 0 || got.Active {
+		t.Errorf("masked columns = %q, %d, %v, want zero values", got.Name, got.Score, got.Active)
+	}
+	if got.Email != "u1@example.com" {
+		t.Errorf("Email = %q, the column out of the mask is expected to be kept", got.Email)
+	}
+
+	for _, paths := range [][]string{nil, {"id"}, {"unknown"}} {
+		_, err := update.ToGorm().SetGorm(store.DB()).UpdateWithMask(ctx, &fieldmaskpb.FieldMask{Paths: paths})
+		if !errors.Is(err, StoreErrUpdateMask) {
+			t.Errorf("UpdateWithMask(%v) error = %v, want StoreErrUpdateMask", paths, err)
+		}
+	}
+}
+
+func TestTxnInterceptor(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+	interceptor := store.TxnInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/store.Store/GetUser"}
+	create := func(id string, fail error) error {
+		_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			if _, ok := StoreFromContext(ctx); !ok {
+				t.Error("transaction is expected in the handler context")
+			}
+			if _, err := newUser(id).ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+				return nil, err
+			}
+			return nil, fail
+		})
+		return err
+	}
+
+	if err := create("u1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.User().GetByID(ctx, "u1"); err != nil {
+		t.Errorf("committed user: %v", err)
+	}
+
+	failure := errors.New("handler failure")
+	if err := create("u2", failure); err != failure {
+		t.Fatalf("interceptor error = %v, want the handler error", err)
+	}
+	if _, err := store.User().GetByID(ctx, "u2"); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("rolled back user: %v, want ErrRecordNotFound", err)
+	}
+
+	// the updates of the context run in the transaction and are rolled back with it
+	_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		update := store.User()
+		update.Id = "u1"
+		update.Name = "renamed"
+		if _, err := update.UpdateIfExist(ctx, false); err != nil {
+			return nil, err
+		}
+		masked := &User{Id: "u1", Score: 99}
+		if _, err := masked.ToGorm().SetGorm(store.DB()).UpdateWithMask(ctx, &fieldmaskpb.FieldMask{Paths: []string{"score"}}); err != nil {
+			return nil, err
+		}
+		return nil, failure
+	})
+	if err != failure {
+		t.Fatalf("interceptor error = %v, want the handler error", err)
+	}
+	got, err := store.User().GetByID(ctx, "u1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "name u1" || got.Score != 10 {
+		t.Errorf("rolled back updates = %q, %d, want name u1, 10", got.Name, got.Score)
+	}
+}
+
+func TestCacheFallThrough(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+	// redis is not reachable, the cached queries read the database
+	client := StoreRedisClient
+	StoreRedisClient = redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", DialTimeout: 100 * time.Millisecond})
+	defer func() { StoreRedisClient = client }()
+
+	interceptor := store.TxnInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/store.Store/GetUser"}
+	_, err := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		created, err := newUser("u1").ToGorm().SetGorm(store.DB()).SetCacheKey("user:u1").Create(ctx)
+		if err == nil {
+			t.Error("Create error is expected when the cache is not invalidated")
+		}
+		if created == nil || created.Id != "u1" {
+			t.Errorf("Create() = %v, want the stored user", created)
+		}
+		// the transaction takes the one connection of the pool, the cached queries run in it
+		first, err := (&UserWORM{Id: "u1"}).SetGorm(store.DB()).SetCacheKey("user:u1").FirstCached(ctx, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first.Email != "u1@example.com" {
+			t.Errorf("FirstCached() email = %q, want u1@example.com", first.Email)
+		}
+		items, err := store.User().SetCacheKey("users").FindCached(ctx, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(items) != 1 {
+			t.Errorf("FindCached() = %d items, want 1", len(items))
+		}
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPaginateBounds(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+	for _, id := range []string{"n1", "n2", "n3"} {
+		if _, err := (&Note{Id: id, Text: "note " + id}).ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the offset of the page does not overflow int32
+	items, pagination, err := store.Note().Paginate(ctx, math.MaxInt32, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 0 || pagination.GetCurrentPage() != math.MaxInt32/50 {
+		t.Errorf("Paginate = %d items, %v, want no items of the clamped page", len(items), pagination)
+	}
+
+	items, pagination, err = store.Note().Paginate(ctx, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 2 || pagination.GetCurrentPage() != 1 || pagination.GetTotalPages() != 2 {
+		t.Errorf("Paginate = %d items, %v, want 2 items of the first page", len(items), pagination)
+	}
+}
+
+func TestWellKnownJSON(t *testing.T) {
+	store := newStore(t)
+	ctx := context.Background()
+
+	data, err := structpb.NewStruct(map[string]interface{}{"theme": "dark", "size": 12.0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	setting := &Setting{Id: "s1", Data: data, Payload: &anypb.Any{TypeUrl: "example.com/Unknown", Value: []byte{1, 2}}}
+	if _, err := setting.ToGorm().SetGorm(store.DB()).Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	got, err := store.Setting().GetByID(ctx, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pb := got.ToPB(); !proto.Equal(pb, setting) {
+		t.Errorf("stored ToPB() = %v, want %v", pb, setting)
+	}
+
+	// NaN has no json, the insert fails instead of storing an empty value
+	invalid := &Setting{Id: "s2", Data: &structpb.Struct{Fields: map[string]*structpb.Value{"size": structpb.NewNumberValue(math.NaN())}}}
+	if _, err := invalid.ToGorm().SetGorm(store.DB()).Create(ctx); err == nil {
+		t.Error("Create of the NaN value is expected to fail")
+	}
+
+	// stored value which is not the message json fails the read
+	if err := store.DB().Exec(`INSERT INTO setting (id, data) VALUES ('s3', '[1, 2]')`).Error; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Setting().GetByID(ctx, "s3"); err == nil {
+		t.Error("GetByID of the invalid json is expected to fail")
+	}
+}
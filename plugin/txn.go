@@ -0,0 +1,77 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// hasTxnMiddleware - check if any autogen service of the file asks for the transactional interceptor
+func (w *WormPlugin) hasTxnMiddleware(file *protogen.File) bool {
+	for _, svc := range file.Services {
+		if opts, ok := w.getServiceOptions(svc); ok && opts.GetTxnMiddleware() {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *WormPlugin) txnContextKey() string {
+	return w.privateNameWithServicePrefix("TxnKey")
+}
+
+func (w *WormPlugin) txnFromContext() string {
+	return w.nameWithServicePrefix("FromContext")
+}
+
+// generateTxnMiddleware - unary interceptor which wraps every call in a transaction of the data store
+func (w *WormPlugin) generateTxnMiddleware() {
+	w.useServer = true
+	w.useGrpc = true
+	store := w.nameWithServicePrefix("DataStore")
+	key := w.txnContextKey()
+	newContext := w.nameWithServicePrefix("NewContext")
+	interceptor := store + ".TxnInterceptor"
+
+	w.P()
+	w.P(`// `, key, ` - context key of the request transaction`)
+	w.P(`type `, key, ` struct{}`)
+	w.P()
+	w.P(`// `, newContext, ` - store gorm transaction in the context`)
+	w.P(`func `, newContext, `(ctx context.Context, tx *gorm.DB) context.Context {`)
+	w.P(`return context.WithValue(ctx, `, key, `{}, tx)`)
+	w.P(`}`)
+	w.P()
+	w.P(`// `, w.txnFromContext(), ` - gorm transaction stored in the context by `, interceptor)
+	w.P(`func `, w.txnFromContext(), `(ctx context.Context) (*gorm.DB, bool) {`)
+	w.P(`if ctx == nil {`)
+	w.P(`return nil, false`)
+	w.P(`}`)
+	w.P(`tx, ok := ctx.Value(`, key, `{}).(*gorm.DB)`)
+	w.P(`return tx, ok`)
+	w.P(`}`)
+	w.P()
+	w.P(`// TxnInterceptor - unary interceptor, opens transaction on the data store connection for every call,`)
+	w.P(`// commits it on success and rolls back on error or panic`)
+	w.P(`func (d *`, store, `) TxnInterceptor() grpc.UnaryServerInterceptor {`)
+	w.P(`return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {`)
+	w.P(`tx := d.db.WithContext(ctx).Begin()`)
+	w.P(`if tx.Error != nil {`)
+	w.P(`return nil, status.Error(codes.Internal, tx.Error.Error())`)
+	w.P(`}`)
+	w.P(`defer func() {`)
+	w.P(`if r := recover(); r != nil {`)
+	w.P(`tx.Rollback()`)
+	w.P(`panic(r)`)
+	w.P(`}`)
+	w.P(`}()`)
+	w.P(`resp, err = handler(`, newContext, `(ctx, tx), req)`)
+	w.P(`if err != nil {`)
+	w.P(`tx.Rollback()`)
+	w.P(`return resp, err`)
+	w.P(`}`)
+	w.P(`if err := tx.Commit().Error; err != nil {`)
+	w.P(`return nil, status.Error(codes.Internal, err.Error())`)
+	w.P(`}`)
+	w.P(`return resp, nil`)
+	w.P(`}`)
+	w.P(`}`)
+}
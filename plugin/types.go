@@ -0,0 +1,190 @@
+package plugin
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fileMessages - messages of the file, every message is followed by its nested messages
+func fileMessages(file *protogen.File) []*protogen.Message {
+	var messages []*protogen.Message
+	var walk func(list []*protogen.Message)
+	walk = func(list []*protogen.Message) {
+		for _, message := range list {
+			messages = append(messages, message)
+			walk(message.Messages)
+		}
+	}
+	walk(file.Messages)
+	return messages
+}
+
+// typeName - fully qualified type of the message or enum field (.google.protobuf.Timestamp)
+func typeName(field *protogen.Field) string {
+	switch {
+	case field.Message != nil:
+		return "." + string(field.Message.Desc.FullName())
+	case field.Enum != nil:
+		return "." + string(field.Enum.Desc.FullName())
+	}
+	return ""
+}
+
+// isRepeated - repeated and map fields
+func isRepeated(field *protogen.Field) bool {
+	return field.Desc.Cardinality() == protoreflect.Repeated
+}
+
+func isMap(field *protogen.Field) bool {
+	return field.Desc.IsMap()
+}
+
+// isMessage - message and group fields, maps are repeated messages of the map entry
+func isMessage(field *protogen.Field) bool {
+	return field.Desc.Kind() == protoreflect.MessageKind || field.Desc.Kind() == protoreflect.GroupKind
+}
+
+func isEnum(field *protogen.Field) bool {
+	return field.Desc.Kind() == protoreflect.EnumKind
+}
+
+func isBytes(field *protogen.Field) bool {
+	return field.Desc.Kind() == protoreflect.BytesKind
+}
+
+// isScalar - numeric, bool and enum fields
+func isScalar(field *protogen.Field) bool {
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind, protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	}
+	return true
+}
+
+// isNumeric - integer and floating point fields, they are converted to each other by a go conversion
+func isNumeric(field *protogen.Field) bool {
+	return isScalar(field) && field.Desc.Kind() != protoreflect.BoolKind && !isEnum(field)
+}
+
+// goIdent - go name of the message or enum, qualified with the package name when it is declared in another go package
+func (w *WormPlugin) goIdent(ident protogen.GoIdent) string {
+	if ident.GoImportPath == w.currentFile.GoImportPath {
+		return ident.GoName
+	}
+	return string(w.packageName(ident.GoImportPath)) + "." + ident.GoName
+}
+
+// packageName - name of the imported go package of the messages, the names taken by the other imports are suffixed
+func (w *WormPlugin) packageName(importPath protogen.GoImportPath) protogen.GoPackageName {
+	if name, ok := w.packages[importPath]; ok {
+		return name
+	}
+	var base protogen.GoPackageName
+	for _, file := range w.gen.Files {
+		if file.GoImportPath == importPath {
+			base = file.GoPackageName
+			break
+		}
+	}
+	name := base
+	for i := 1; w.isPackageNameTaken(name); i++ {
+		name = protogen.GoPackageName(string(base) + strconv.Itoa(i))
+	}
+	w.packages[importPath] = name
+	return name
+}
+
+func (w *WormPlugin) isPackageNameTaken(name protogen.GoPackageName) bool {
+	if name == w.currentFile.GoPackageName || reservedImports[string(name)] {
+		return true
+	}
+	for _, taken := range w.packages {
+		if taken == name {
+			return true
+		}
+	}
+	return false
+}
+
+// goElemType - go type of the single value of the field as it is declared by protoc-gen-go
+func (w *WormPlugin) goElemType(field *protogen.Field) string {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "bool"
+	case protoreflect.EnumKind:
+		return w.goIdent(field.Enum.GoIdent)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64"
+	case protoreflect.FloatKind:
+		return "float32"
+	case protoreflect.DoubleKind:
+		return "float64"
+	case protoreflect.StringKind:
+		return "string"
+	case protoreflect.BytesKind:
+		return "[]byte"
+	}
+	return "*" + w.goIdent(field.Message.GoIdent)
+}
+
+// goType - go type of the field in the protoc-gen-go message, scalars with explicit presence are pointers
+func (w *WormPlugin) goType(field *protogen.Field) string {
+	switch {
+	case isMap(field):
+		return "map[" + w.goElemType(field.Message.Fields[0]) + "]" + w.goElemType(field.Message.Fields[1])
+	case isRepeated(field):
+		return "[]" + w.goElemType(field)
+	case w.isOptional(field) && !isBytes(field):
+		return "*" + w.goElemType(field)
+	}
+	return w.goElemType(field)
+}
+
+// camelCase - go name of the protobuf name as it is built by protoc-gen-go (total_count -> TotalCount)
+func camelCase(s string) string {
+	if s == "" {
+		return ""
+	}
+	t := make([]byte, 0, 32)
+	i := 0
+	if s[0] == '_' {
+		// the leading underscore becomes X
+		t = append(t, 'X')
+		i++
+	}
+	for ; i < len(s); i++ {
+		c := s[i]
+		if c == '_' && i+1 < len(s) && isASCIILower(s[i+1]) {
+			continue
+		}
+		if isASCIIDigit(c) {
+			t = append(t, c)
+			continue
+		}
+		if isASCIILower(c) {
+			c ^= ' '
+		}
+		t = append(t, c)
+		for i+1 < len(s) && isASCIILower(s[i+1]) {
+			i++
+			t = append(t, s[i])
+		}
+	}
+	return string(t)
+}
+
+func isASCIILower(c byte) bool {
+	return 'a' <= c && c <= 'z'
+}
+
+func isASCIIDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
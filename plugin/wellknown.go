@@ -0,0 +1,288 @@
+package plugin
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// WrapperType - model mapping of the google.protobuf wrapper message
+type WrapperType struct {
+	goType  string
+	message string
+	kind    protoreflect.Kind
+}
+
+// wrapperTypes - wrapper messages are stored as nullable columns of the wrapped scalar
+var wrapperTypes = map[string]WrapperType{
+	".google.protobuf.StringValue": {goType: "*string", message: "StringValue", kind: protoreflect.StringKind},
+	".google.protobuf.BytesValue":  {goType: "[]byte", message: "BytesValue", kind: protoreflect.BytesKind},
+	".google.protobuf.BoolValue":   {goType: "*bool", message: "BoolValue", kind: protoreflect.BoolKind},
+	".google.protobuf.Int32Value":  {goType: "*int32", message: "Int32Value", kind: protoreflect.Int32Kind},
+	".google.protobuf.Int64Value":  {goType: "*int64", message: "Int64Value", kind: protoreflect.Int64Kind},
+	".google.protobuf.UInt32Value": {goType: "*uint32", message: "UInt32Value", kind: protoreflect.Uint32Kind},
+	".google.protobuf.UInt64Value": {goType: "*uint64", message: "UInt64Value", kind: protoreflect.Uint64Kind},
+	".google.protobuf.FloatValue":  {goType: "*float32", message: "FloatValue", kind: protoreflect.FloatKind},
+	".google.protobuf.DoubleValue": {goType: "*float64", message: "DoubleValue", kind: protoreflect.DoubleKind},
+}
+
+// wrapperType - mapping of the singular wrapper field
+func (w *WormPlugin) wrapperType(field *protogen.Field) (WrapperType, bool) {
+	if isRepeated(field) {
+		return WrapperType{}, false
+	}
+	wrapper, ok := wrapperTypes[typeName(field)]
+	return wrapper, ok
+}
+
+// wrapperToGorm - unwrap the protobuf wrapper value, nil wrapper is stored as NULL
+func (w *WormPlugin) wrapperToGorm(fieldName string, wrapper WrapperType) {
+	w.P(`// nullable `, fieldName, ` value`)
+	w.P(`if e.`, fieldName, ` != nil {`)
+	if wrapper.kind == protoreflect.BytesKind {
+		w.P(`resp.`, fieldName, ` = e.`, fieldName, `.GetValue()`)
+	} else {
+		w.P(`v`, fieldName, ` := e.`, fieldName, `.GetValue()`)
+		w.P(`resp.`, fieldName, ` = &v`, fieldName)
+	}
+	w.P(`}`)
+}
+
+// wrapperToPB - wrap the nullable column value, NULL is returned as nil wrapper
+func (w *WormPlugin) wrapperToPB(fieldName string, wrapper WrapperType) {
+	w.useWrappers = true
+	w.P(`// nullable `, fieldName, ` value`)
+	w.P(`if e.`, fieldName, ` != nil {`)
+	if wrapper.kind == protoreflect.BytesKind {
+		w.P(`resp.`, fieldName, ` = &wrapperspb.`, wrapper.message, `{Value: e.`, fieldName, `}`)
+	} else {
+		w.P(`resp.`, fieldName, ` = &wrapperspb.`, wrapper.message, `{Value: *e.`, fieldName, `}`)
+	}
+	w.P(`}`)
+}
+
+const (
+	durationType  = ".google.protobuf.Duration"
+	structType    = ".google.protobuf.Struct"
+	valueType     = ".google.protobuf.Value"
+	listValueType = ".google.protobuf.ListValue"
+	anyType       = ".google.protobuf.Any"
+)
+
+// isWellKnownJSON - Struct, Value, ListValue and Any are stored as json columns
+func (w *WormPlugin) isWellKnownJSON(field *protogen.Field) bool {
+	if isRepeated(field) {
+		return false
+	}
+	switch typeName(field) {
+	case structType, valueType, listValueType, anyType:
+		return true
+	}
+	return false
+}
+
+// isWellKnownColumn - well known message stored in a single column of the model
+func (w *WormPlugin) isWellKnownColumn(field *protogen.Field) bool {
+	if _, ok := w.wrapperType(field); ok {
+		return true
+	}
+	if isRepeated(field) {
+		return false
+	}
+	switch typeName(field) {
+	case timestampType, durationType, fieldMaskType:
+		return true
+	}
+	return w.isWellKnownJSON(field)
+}
+
+// wellKnownGoType - model type of the Duration, Struct, Value, ListValue, Any and FieldMask fields,
+// the Duration is a pointer, nil is the unset duration
+func (w *WormPlugin) wellKnownGoType(field *protogen.Field) (string, bool) {
+	if isRepeated(field) {
+		return "", false
+	}
+	switch {
+	case typeName(field) == durationType:
+		w.useTime = true
+		return "*time.Duration", true
+	case typeName(field) == fieldMaskType:
+		return "string", true
+	case w.isWellKnownJSON(field):
+		return w.wellKnownJSONName(field), true
+	}
+	return "", false
+}
+
+// wellKnownMessage - go type of the Struct, Value, ListValue and Any message
+func (w *WormPlugin) wellKnownMessage(field *protogen.Field) string {
+	if typeName(field) == anyType {
+		w.useAny = true
+		return "anypb.Any"
+	}
+	w.useStruct = true
+	return "structpb." + strings.TrimPrefix(typeName(field), ".google.protobuf.")
+}
+
+// wellKnownJSONName - model type of the json column of the Struct, Value, ListValue and Any fields
+func (w *WormPlugin) wellKnownJSONName(field *protogen.Field) string {
+	return w.nameWithServicePrefix(strings.TrimPrefix(typeName(field), ".google.protobuf.") + "JSON")
+}
+
+// generateWellKnownJSONTypes - json column types of the Struct, Value, ListValue and Any fields shared by the file models
+func (w *WormPlugin) generateWellKnownJSONTypes(file *protogen.File) {
+	seen := make(map[string]bool)
+	for _, msg := range fileMessages(file) {
+		for _, field := range msg.Fields {
+			if !w.isWellKnownJSON(field) || w.isOneOf(field) || seen[typeName(field)] {
+				continue
+			}
+			seen[typeName(field)] = true
+			w.generateJSONMessageType(w.wellKnownJSONName(field), w.wellKnownMessage(field), typeName(field) == anyType)
+		}
+	}
+}
+
+// generateJSONMessageType - message stored in a json column, implements sql Scanner and driver Valuer,
+// the marshal and unmarshal errors are returned by the queries of the model,
+// Any keeps the type url and the bytes of the value as it is not resolved by protojson
+func (w *WormPlugin) generateJSONMessageType(name, message string, isAny bool) {
+	w.useDriver = true
+	w.useJsonb = true
+	w.useGormSchema = true
+	if !isAny {
+		w.useProtoJSON = true
+	}
+
+	w.P()
+	w.P(`// `, name, ` - `, message, ` stored as json, nil message is stored as NULL`)
+	w.P(`type `, name, ` struct {`)
+	w.P(`Message *`, message)
+	w.P(`}`)
+	if isAny {
+		w.P()
+		w.P(`// `, w.privateName(name), `Value - stored json of the Any message`)
+		w.P(`type `, w.privateName(name), `Value struct {`)
+		w.P("TypeUrl string `json:\"typeUrl\"`")
+		w.P("Value   []byte `json:\"value\"`")
+		w.P(`}`)
+	}
+	w.P()
+	w.P(`// Value - json of the message`)
+	w.P(`func (x `, name, `) Value() (driver.Value, error) {`)
+	w.P(`if x.Message == nil {`)
+	w.P(`return nil, nil`)
+	w.P(`}`)
+	if isAny {
+		w.P(`var json = jsoniter.ConfigCompatibleWithStandardLibrary`)
+		w.P(`bts, err := json.Marshal(`, w.privateName(name), `Value{TypeUrl: x.Message.GetTypeUrl(), Value: x.Message.GetValue()})`)
+	} else {
+		w.P(`bts, err := protojson.Marshal(x.Message)`)
+	}
+	w.P(`if err != nil {`)
+	w.P(`return nil, err`)
+	w.P(`}`)
+	w.P(`return string(bts), nil`)
+	w.P(`}`)
+	w.P()
+	w.P(`// Scan - message of the stored json`)
+	w.P(`func (x *`, name, `) Scan(src interface{}) error {`)
+	w.P(`var data []byte`)
+	w.P(`switch v := src.(type) {`)
+	w.P(`case nil:`)
+	w.P(`x.Message = nil`)
+	w.P(`return nil`)
+	w.P(`case []byte:`)
+	w.P(`data = v`)
+	w.P(`case string:`)
+	w.P(`data = []byte(v)`)
+	w.P(`default:`)
+	w.P(`return fmt.Errorf("can not scan %T into `, message, `", src)`)
+	w.P(`}`)
+	if isAny {
+		w.P(`var json = jsoniter.ConfigCompatibleWithStandardLibrary`)
+		w.P(`var value `, w.privateName(name), `Value`)
+		w.P(`if err := json.Unmarshal(data, &value); err != nil {`)
+		w.P(`return err`)
+		w.P(`}`)
+		w.P(`x.Message = &`, message, `{TypeUrl: value.TypeUrl, Value: value.Value}`)
+	} else {
+		w.P(`message := &`, message, `{}`)
+		w.P(`if err := protojson.Unmarshal(data, message); err != nil {`)
+		w.P(`return err`)
+		w.P(`}`)
+		w.P(`x.Message = message`)
+	}
+	w.P(`return nil`)
+	w.P(`}`)
+	w.P()
+	w.P(`// GormDataType - json data type of gorm, the message is a column and not a relation`)
+	w.P(`func (`, name, `) GormDataType() string {`)
+	w.P(`return datatypes.JSON{}.GormDataType()`)
+	w.P(`}`)
+	w.P()
+	w.P(`// GormDBDataType - json column type of the driver`)
+	w.P(`func (`, name, `) GormDBDataType(db *gorm.DB, field *schema.Field) string {`)
+	w.P(`return datatypes.JSON{}.GormDBDataType(db, field)`)
+	w.P(`}`)
+}
+
+// wellKnownToGorm - convert well known message to the model value
+func (w *WormPlugin) wellKnownToGorm(field *protogen.Field, fieldName string) bool {
+	if _, ok := w.wellKnownGoType(field); !ok {
+		return false
+	}
+	w.P(`// convert `, fieldName, ` `, typeName(field))
+	w.P(`if e.`, fieldName, ` != nil {`)
+	switch typeName(field) {
+	case durationType:
+		w.P(`v`, fieldName, ` := e.`, fieldName, `.AsDuration()`)
+		w.P(`resp.`, fieldName, ` = &v`, fieldName)
+	case fieldMaskType:
+		w.useStrings = true
+		w.P(`resp.`, fieldName, ` = strings.Join(e.`, fieldName, `.GetPaths(), ",")`)
+	default:
+		w.P(`resp.`, fieldName, ` = `, w.wellKnownJSONName(field), `{Message: e.`, fieldName, `}`)
+	}
+	w.P(`}`)
+	return true
+}
+
+// wellKnownToPB - convert model value to the well known message
+func (w *WormPlugin) wellKnownToPB(field *protogen.Field, fieldName string) bool {
+	if _, ok := w.wellKnownGoType(field); !ok {
+		return false
+	}
+	w.P(`// convert `, fieldName, ` `, typeName(field))
+	switch typeName(field) {
+	case durationType:
+		w.useDuration = true
+		w.P(`if e.`, fieldName, ` != nil {`)
+		w.P(`resp.`, fieldName, ` = durationpb.New(*e.`, fieldName, `)`)
+		w.P(`}`)
+	case fieldMaskType:
+		w.useStrings = true
+		w.useFieldMask = true
+		w.P(`if len(e.`, fieldName, `) > 0 {`)
+		w.P(`resp.`, fieldName, ` = &fieldmaskpb.FieldMask{Paths: strings.Split(e.`, fieldName, `, ",")}`)
+		w.P(`}`)
+	default:
+		w.P(`resp.`, fieldName, ` = e.`, fieldName, `.Message`)
+	}
+	return true
+}
+
+// wellKnownUpdateCondition - condition of the UpdateIfExist check of the well known field
+func (w *WormPlugin) wellKnownUpdateCondition(field *protogen.Field, fieldName string) (string, bool) {
+	if _, ok := w.wellKnownGoType(field); !ok {
+		return "", false
+	}
+	switch typeName(field) {
+	case durationType:
+		return `e.` + fieldName + ` != nil`, true
+	case fieldMaskType:
+		return `len(e.` + fieldName + `) > 0`, true
+	}
+	return `e.` + fieldName + `.Message != nil`, true
+}